@@ -0,0 +1,2 @@
+terraform import discord_application_command.example "<command id>"
+terraform import discord_application_command.example "<server id>:<command id>"
\ No newline at end of file
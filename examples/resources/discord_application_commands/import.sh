@@ -0,0 +1,2 @@
+terraform import discord_application_commands.example "<server id>"
+terraform import discord_application_commands.example "global"
\ No newline at end of file
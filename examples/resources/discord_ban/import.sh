@@ -0,0 +1 @@
+terraform import discord_ban.example "<server id>:<user id>"
\ No newline at end of file
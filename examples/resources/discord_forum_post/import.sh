@@ -0,0 +1 @@
+terraform import discord_forum_post.example "<post id>"
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import discord_media_channel.example "<server id>:<channel id>"
@@ -0,0 +1 @@
+terraform import discord_member.example "<server id>:<member id>"
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import discord_scheduled_event.example "<server id>:<event id>"
\ No newline at end of file
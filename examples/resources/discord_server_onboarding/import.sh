@@ -0,0 +1 @@
+terraform import discord_server_onboarding.example "<server id>"
\ No newline at end of file
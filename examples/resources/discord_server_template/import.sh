@@ -0,0 +1 @@
+terraform import discord_server_template.example "<server id>:<code>"
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import discord_stage_channel.example "<channel id>"
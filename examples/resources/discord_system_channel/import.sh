@@ -1 +1 @@
-terraform import discord_system_channel.example "<channel id>"
\ No newline at end of file
+terraform import discord_system_channel.example "<server id>"
\ No newline at end of file
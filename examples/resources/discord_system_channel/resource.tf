@@ -0,0 +1,7 @@
+resource "discord_text_channel" "welcome" {
+  name = "welcome"
+}
+
+resource "discord_system_channel" "this" {
+  system_channel_id = discord_text_channel.welcome.id
+}
@@ -0,0 +1,323 @@
+package discordtest
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+	"regexp"
+	"sort"
+)
+
+// The command limits Discord enforces.
+const (
+	maxCommandOptions = 25
+	maxOptionChoices  = 25
+)
+
+// chatInputNamePattern matches the names of chat input commands and options, which must be lowercase.
+var chatInputNamePattern = regexp.MustCompile(`^[-_\p{Ll}\p{Lm}\p{Lo}\p{N}]{1,32}$`)
+
+// commandScope returns the commands of an application in a server, or its global commands when guildID is empty.
+// The commands are sorted by ID, which sorts them by when they were created.
+func (s *Server) commandScope(appID string, guildID string) []Object {
+	var commands []Object
+	for _, command := range s.commands {
+		if stringField(command, "application_id") == appID && stringField(command, "guild_id") == guildID {
+			commands = append(commands, command)
+		}
+	}
+	sort.Slice(commands, func(i, j int) bool {
+		return stringField(commands[i], "id") < stringField(commands[j], "id")
+	})
+
+	return commands
+}
+
+// application checks the application ID of a request, and the server for requests for the commands of a server.
+func (s *Server) application(w http.ResponseWriter, params []string) (string, bool) {
+	if params[0] != stringField(s.Application, "id") {
+		writeUnknown(w, discordgo.ErrCodeUnknownApplication, "Unknown Application")
+		return "", false
+	}
+	if len(params) > 1 {
+		if _, ok := s.guild(w, params[1]); !ok {
+			return "", false
+		}
+		return params[1], true
+	}
+
+	return "", true
+}
+
+func (s *Server) command(w http.ResponseWriter, guildID string, commandID string) (Object, bool) {
+	command, ok := s.commands[commandID]
+	if !ok || stringField(command, "guild_id") != guildID {
+		writeUnknown(w, discordgo.ErrCodeUnknownApplicationCommand, "Unknown application command")
+		return nil, false
+	}
+
+	return command, true
+}
+
+// validOptions checks the options of a command, or of a subcommand or subcommand group at the given depth. Top level
+// options are either all subcommands and groups or none, groups only hold subcommands, and subcommands only hold
+// options. Required options must come first.
+func validOptions(options []Object, depth int) bool {
+	if len(options) > maxCommandOptions {
+		return false
+	}
+	names := map[string]bool{}
+	subcommands, optional := 0, false
+	for _, option := range options {
+		optionType := discordgo.ApplicationCommandOptionType(number(option["type"]))
+		name := stringField(option, "name")
+		if !chatInputNamePattern.MatchString(name) || names[name] {
+			return false
+		}
+		names[name] = true
+		if description := len(stringField(option, "description")); description < 1 || description > 100 {
+			return false
+		}
+
+		nested := objects(option, "options")
+		switch optionType {
+		case discordgo.ApplicationCommandOptionSubCommandGroup:
+			subcommands++
+			if depth != 0 || len(nested) == 0 || !validOptions(nested, 1) {
+				return false
+			}
+			for _, subcommand := range nested {
+				if number(subcommand["type"]) != float64(discordgo.ApplicationCommandOptionSubCommand) {
+					return false
+				}
+			}
+			continue
+		case discordgo.ApplicationCommandOptionSubCommand:
+			subcommands++
+			if depth > 1 || !validOptions(nested, 2) {
+				return false
+			}
+			continue
+		}
+		if optionType < discordgo.ApplicationCommandOptionString || optionType > discordgo.ApplicationCommandOptionAttachment || len(nested) > 0 {
+			return false
+		}
+		required, _ := option["required"].(bool)
+		if required && optional {
+			return false
+		}
+		optional = optional || !required
+
+		choices := objects(option, "choices")
+		numeric := optionType == discordgo.ApplicationCommandOptionInteger || optionType == discordgo.ApplicationCommandOptionNumber
+		autocomplete, _ := option["autocomplete"].(bool)
+		if len(choices) > 0 && (autocomplete || len(choices) > maxOptionChoices || !numeric && optionType != discordgo.ApplicationCommandOptionString) {
+			return false
+		}
+		if autocomplete && !numeric && optionType != discordgo.ApplicationCommandOptionString {
+			return false
+		}
+		if (option["min_value"] != nil || option["max_value"] != nil) && !numeric {
+			return false
+		}
+		if (option["min_length"] != nil || option["max_length"] != nil) && optionType != discordgo.ApplicationCommandOptionString {
+			return false
+		}
+		if option["channel_types"] != nil && optionType != discordgo.ApplicationCommandOptionChannel {
+			return false
+		}
+	}
+
+	return subcommands == 0 || subcommands == len(options)
+}
+
+// validCommand checks a command. Only chat input commands have a description and options, and their names must be
+// lowercase.
+func validCommand(command Object) bool {
+	name := stringField(command, "name")
+	description := stringField(command, "description")
+	options := objects(command, "options")
+	if number(command["type"]) != float64(discordgo.ChatApplicationCommand) {
+		return len(name) >= 1 && len(name) <= 32 && description == "" && len(options) == 0
+	}
+
+	return chatInputNamePattern.MatchString(name) && len(description) >= 1 && len(description) <= 100 && validOptions(options, 0)
+}
+
+// newCommand returns a command created from a request body, with the defaults Discord gives commands.
+func (s *Server) newCommand(guildID string, body Object) Object {
+	command := Object{
+		"id":                         s.snowflakes.Next(),
+		"application_id":             s.Application["id"],
+		"type":                       int(discordgo.ChatApplicationCommand),
+		"name_localizations":         nil,
+		"description":                "",
+		"description_localizations":  nil,
+		"default_member_permissions": nil,
+		"dm_permission":              true,
+		"nsfw":                       false,
+		"integration_types":          []any{float64(0)},
+		"contexts":                   nil,
+	}
+	if guildID != "" {
+		command["guild_id"] = guildID
+	} else {
+		command["contexts"] = []any{float64(0), float64(1), float64(2)}
+	}
+	s.setCommand(command, body)
+
+	return command
+}
+
+// setCommand copies the fields of a request body onto a command and gives it a new version. Missing contexts are
+// left as they are, the same way Discord does.
+func (s *Server) setCommand(command Object, body Object) {
+	for k, v := range body {
+		if k == "id" || k == "application_id" || k == "guild_id" || k == "version" || (k == "contexts" && v == nil) {
+			continue
+		}
+		command[k] = v
+	}
+	command["version"] = s.snowflakes.Next()
+}
+
+// sameCommand returns the command of a scope with the name and type of body, which Discord updates instead of
+// creating another one.
+func sameCommand(commands []Object, body Object) Object {
+	commandType := number(body["type"])
+	if commandType == 0 {
+		commandType = float64(discordgo.ChatApplicationCommand)
+	}
+	for _, command := range commands {
+		if stringField(command, "name") == stringField(body, "name") && number(command["type"]) == commandType {
+			return command
+		}
+	}
+
+	return nil
+}
+
+func getApplicationCommands(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	guildID, ok := s.application(w, params)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, toAny(s.commandScope(params[0], guildID)))
+}
+
+func createApplicationCommand(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	guildID, ok := s.application(w, params)
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if body["type"] == nil {
+		body["type"] = float64(discordgo.ChatApplicationCommand)
+	}
+	if !validCommand(body) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	if command := sameCommand(s.commandScope(params[0], guildID), body); command != nil {
+		s.setCommand(command, body)
+		writeJSON(w, http.StatusOK, command)
+		return
+	}
+	command := s.newCommand(guildID, body)
+	s.commands[stringField(command, "id")] = command
+	writeJSON(w, http.StatusCreated, command)
+}
+
+// overwriteApplicationCommands replaces the commands of a scope. Commands with the name and type of an existing one
+// keep its ID.
+func overwriteApplicationCommands(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	guildID, ok := s.application(w, params)
+	if !ok {
+		return
+	}
+	var body []Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	for i, command := range body {
+		if command["type"] == nil {
+			command["type"] = float64(discordgo.ChatApplicationCommand)
+		}
+		if !validCommand(command) || sameCommand(body[:i], command) != nil {
+			writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+			return
+		}
+	}
+
+	existing := s.commandScope(params[0], guildID)
+	for _, command := range existing {
+		delete(s.commands, stringField(command, "id"))
+	}
+	overwritten := []any{}
+	for _, data := range body {
+		command := sameCommand(existing, data)
+		if command != nil {
+			s.setCommand(command, data)
+		} else {
+			command = s.newCommand(guildID, data)
+		}
+		s.commands[stringField(command, "id")] = command
+		overwritten = append(overwritten, command)
+	}
+	writeJSON(w, http.StatusOK, overwritten)
+}
+
+func getApplicationCommand(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	guildID, ok := s.application(w, params[:len(params)-1])
+	if !ok {
+		return
+	}
+	command, ok := s.command(w, guildID, params[len(params)-1])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, command)
+}
+
+func editApplicationCommand(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	guildID, ok := s.application(w, params[:len(params)-1])
+	if !ok {
+		return
+	}
+	command, ok := s.command(w, guildID, params[len(params)-1])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	delete(body, "type")
+	edited := Object{}
+	merge(edited, command)
+	merge(edited, body)
+	if !validCommand(edited) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	if same := sameCommand(s.commandScope(params[0], guildID), edited); same != nil && stringField(same, "id") != stringField(command, "id") {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Application command names must be unique")
+		return
+	}
+	s.setCommand(command, body)
+	writeJSON(w, http.StatusOK, command)
+}
+
+func deleteApplicationCommand(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	guildID, ok := s.application(w, params[:len(params)-1])
+	if !ok {
+		return
+	}
+	if _, ok := s.command(w, guildID, params[len(params)-1]); !ok {
+		return
+	}
+	delete(s.commands, params[len(params)-1])
+	writeNoContent(w)
+}
@@ -0,0 +1,195 @@
+package discordtest
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+)
+
+// The AutoMod types discordgo does not have.
+const (
+	autoModEventMemberUpdate            = 2
+	autoModTriggerMentionSpam           = 5
+	autoModTriggerMemberProfile         = 6
+	autoModActionBlockMemberInteraction = 4
+	// errCodeUnknownAutoModRule is the code Discord answers missing rules with, which discordgo has under the name of
+	// the application command permissions.
+	errCodeUnknownAutoModRule = 10066
+)
+
+// autoModTriggerLimits is how many rules of each trigger type a server can have, and autoModMetadata the trigger
+// metadata fields Discord returns for it.
+var (
+	autoModTriggerLimits = map[float64]int{
+		float64(discordgo.AutoModerationEventTriggerKeyword):       6,
+		float64(discordgo.AutoModerationEventTriggerSpam):          1,
+		float64(discordgo.AutoModerationEventTriggerKeywordPreset): 1,
+		float64(autoModTriggerMentionSpam):                         1,
+		float64(autoModTriggerMemberProfile):                       1,
+	}
+	autoModMetadata = map[float64]Object{
+		float64(discordgo.AutoModerationEventTriggerKeyword):       {"keyword_filter": []any{}, "regex_patterns": []any{}, "allow_list": []any{}},
+		float64(discordgo.AutoModerationEventTriggerSpam):          {},
+		float64(discordgo.AutoModerationEventTriggerKeywordPreset): {"presets": []any{}, "allow_list": []any{}},
+		float64(autoModTriggerMentionSpam):                         {"mention_total_limit": 0, "mention_raid_protection_enabled": false},
+		float64(autoModTriggerMemberProfile):                       {"keyword_filter": []any{}, "regex_patterns": []any{}, "allow_list": []any{}},
+	}
+)
+
+func (s *Server) autoModRule(w http.ResponseWriter, guildID string, ruleID string) (Object, bool) {
+	if _, ok := s.guild(w, guildID); !ok {
+		return nil, false
+	}
+	rule, ok := s.autoModRules[ruleID]
+	if !ok || stringField(rule, "guild_id") != guildID {
+		writeUnknown(w, errCodeUnknownAutoModRule, "Unknown Auto Moderation Rule")
+		return nil, false
+	}
+
+	return rule, true
+}
+
+// validAutoModRule checks the event type and actions Discord allows for the trigger type of a rule, and that the
+// channels it refers to exist.
+func (s *Server) validAutoModRule(guildID string, rule Object) bool {
+	trigger := number(rule["trigger_type"])
+	eventType := float64(discordgo.AutoModerationEventMessageSend)
+	if trigger == float64(autoModTriggerMemberProfile) {
+		eventType = float64(autoModEventMemberUpdate)
+	}
+	if name := len(stringField(rule, "name")); name < 1 || name > 100 || number(rule["event_type"]) != eventType {
+		return false
+	}
+
+	actions := objects(rule, "actions")
+	if len(actions) == 0 {
+		return false
+	}
+	for _, action := range actions {
+		metadata, _ := action["metadata"].(Object)
+		switch number(action["type"]) {
+		case float64(discordgo.AutoModerationRuleActionBlockMessage):
+			if len(stringField(metadata, "custom_message")) > 150 {
+				return false
+			}
+		case float64(discordgo.AutoModerationRuleActionSendAlertMessage):
+			channel, ok := s.channels[stringField(metadata, "channel_id")]
+			if !ok || stringField(channel, "guild_id") != guildID {
+				return false
+			}
+		case float64(discordgo.AutoModerationRuleActionTimeout):
+			duration := number(metadata["duration_seconds"])
+			if duration < 1 || duration > 2419200 || (trigger != float64(discordgo.AutoModerationEventTriggerKeyword) && trigger != float64(autoModTriggerMentionSpam)) {
+				return false
+			}
+		case float64(autoModActionBlockMemberInteraction):
+			if trigger != float64(autoModTriggerMemberProfile) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	exemptRoles, _ := rule["exempt_roles"].([]any)
+	exemptChannels, _ := rule["exempt_channels"].([]any)
+
+	return len(exemptRoles) <= 20 && len(exemptChannels) <= 50
+}
+
+// normalizeAutoModRule fills in the trigger and action metadata fields Discord always returns.
+func normalizeAutoModRule(rule Object) {
+	metadata := Object{}
+	merge(metadata, autoModMetadata[number(rule["trigger_type"])])
+	if body, ok := rule["trigger_metadata"].(Object); ok {
+		for k, v := range body {
+			if _, known := metadata[k]; known {
+				metadata[k] = v
+			}
+		}
+	}
+	rule["trigger_metadata"] = metadata
+	for _, action := range objects(rule, "actions") {
+		if action["metadata"] == nil {
+			action["metadata"] = Object{}
+		}
+	}
+	for _, key := range []string{"exempt_roles", "exempt_channels"} {
+		if rule[key] == nil {
+			rule[key] = []any{}
+		}
+	}
+}
+
+func getGuildAutoModRule(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	rule, ok := s.autoModRule(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, rule)
+}
+
+func createGuildAutoModRule(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.guild(w, params[0]); !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	rule := Object{
+		"id":         s.snowflakes.Next(),
+		"guild_id":   params[0],
+		"creator_id": s.BotUser["id"],
+		"enabled":    false,
+	}
+	merge(rule, body)
+	normalizeAutoModRule(rule)
+	limit, ok := autoModTriggerLimits[number(rule["trigger_type"])]
+	if !ok || !s.validAutoModRule(params[0], rule) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	for _, existing := range s.autoModRules {
+		if stringField(existing, "guild_id") == params[0] && number(existing["trigger_type"]) == number(rule["trigger_type"]) {
+			limit--
+		}
+	}
+	if limit <= 0 {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Maximum number of rules of this type reached")
+		return
+	}
+	s.autoModRules[stringField(rule, "id")] = rule
+	writeJSON(w, http.StatusOK, rule)
+}
+
+func editGuildAutoModRule(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	rule, ok := s.autoModRule(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if trigger, ok := body["trigger_type"]; ok && number(trigger) != number(rule["trigger_type"]) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	edited := Object{}
+	merge(edited, rule)
+	merge(edited, body)
+	normalizeAutoModRule(edited)
+	if !s.validAutoModRule(params[0], edited) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	merge(rule, edited)
+	writeJSON(w, http.StatusOK, rule)
+}
+
+func deleteGuildAutoModRule(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.autoModRule(w, params[0], params[1]); !ok {
+		return
+	}
+	delete(s.autoModRules, params[1])
+	writeNoContent(w)
+}
@@ -0,0 +1,106 @@
+package discordtest
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+func (s *Server) user(w http.ResponseWriter, userID string) (Object, bool) {
+	user, ok := s.users[userID]
+	if !ok {
+		writeUnknown(w, discordgo.ErrCodeUnknownUser, "Unknown User")
+	}
+
+	return user, ok
+}
+
+func getGuildBans(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.guild(w, params[0]); !ok {
+		return
+	}
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 1000
+	}
+	after, _ := strconv.ParseInt(r.URL.Query().Get("after"), 10, 64)
+
+	bans := []Object{}
+	for userID, ban := range s.bans[params[0]] {
+		if id, _ := strconv.ParseInt(userID, 10, 64); id > after {
+			bans = append(bans, ban)
+		}
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		a, _ := strconv.ParseInt(stringField(bans[i]["user"].(Object), "id"), 10, 64)
+		b, _ := strconv.ParseInt(stringField(bans[j]["user"].(Object), "id"), 10, 64)
+		return a < b
+	})
+	if len(bans) > limit {
+		bans = bans[:limit]
+	}
+	writeJSON(w, http.StatusOK, bans)
+}
+
+func getGuildBan(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.guild(w, params[0]); !ok {
+		return
+	}
+	ban, ok := s.bans[params[0]][params[1]]
+	if !ok {
+		writeUnknown(w, discordgo.ErrCodeUnknownBan, "Unknown Ban")
+		return
+	}
+	writeJSON(w, http.StatusOK, ban)
+}
+
+func createGuildBan(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.guild(w, params[0]); !ok {
+		return
+	}
+	user, ok := s.user(w, params[1])
+	if !ok {
+		return
+	}
+	body := Object{}
+	if r.ContentLength != 0 && !decodeBody(w, r, &body) {
+		return
+	}
+	if seconds := number(body["delete_message_seconds"]); seconds < 0 || seconds > 604800 {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+
+	// Like Discord, the ban reason is the audit log reason of the request.
+	var reason any
+	if header := r.Header.Get("X-Audit-Log-Reason"); header != "" {
+		reason, _ = url.PathUnescape(header)
+	}
+	if s.bans[params[0]] == nil {
+		s.bans[params[0]] = map[string]Object{}
+	}
+	s.bans[params[0]][params[1]] = Object{"user": user, "reason": reason}
+
+	members := s.members[params[0]]
+	for i, member := range members {
+		if user, _ := member["user"].(Object); stringField(user, "id") == params[1] {
+			s.members[params[0]] = append(members[:i], members[i+1:]...)
+			break
+		}
+	}
+	writeNoContent(w)
+}
+
+func deleteGuildBan(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.guild(w, params[0]); !ok {
+		return
+	}
+	if _, ok := s.bans[params[0]][params[1]]; !ok {
+		writeUnknown(w, discordgo.ErrCodeUnknownBan, "Unknown Ban")
+		return
+	}
+	delete(s.bans[params[0]], params[1])
+	writeNoContent(w)
+}
@@ -0,0 +1,300 @@
+package discordtest
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"math/rand"
+	"net/http"
+)
+
+const inviteCodeCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// AddChannel creates a channel in a server and returns its ID.
+func (s *Server) AddChannel(guildID string, name string, channelType discordgo.ChannelType) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return stringField(s.newChannel(guildID, Object{"name": name, "type": int(channelType)}), "id")
+}
+
+// DeleteChannel removes a channel as if it had been deleted outside of Terraform.
+func (s *Server) DeleteChannel(channelID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeChannel(channelID)
+}
+
+func (s *Server) newChannel(guildID string, body Object) Object {
+	channel := Object{
+		"id":                                 s.snowflakes.Next(),
+		"guild_id":                           guildID,
+		"type":                               0,
+		"name":                               "",
+		"position":                           len(s.channels),
+		"topic":                              nil,
+		"nsfw":                               false,
+		"parent_id":                          nil,
+		"rate_limit_per_user":                0,
+		"bitrate":                            0,
+		"user_limit":                         0,
+		"rtc_region":                         nil,
+		"flags":                              0,
+		"permission_overwrites":              []any{},
+		"available_tags":                     []any{},
+		"default_reaction_emoji":             nil,
+		"default_sort_order":                 nil,
+		"default_forum_layout":               0,
+		"default_thread_rate_limit_per_user": 0,
+		"default_auto_archive_duration":      4320,
+	}
+	delete(body, "id")
+	merge(channel, body)
+	if t := number(channel["type"]); t == float64(discordgo.ChannelTypeGuildVoice) || t == float64(discordgo.ChannelTypeGuildStageVoice) {
+		if number(channel["bitrate"]) == 0 {
+			channel["bitrate"] = 64000
+		}
+	}
+
+	// Channels created without overwrites inherit them from their category.
+	if _, ok := body["permission_overwrites"]; !ok {
+		if parent, ok := s.channels[stringField(channel, "parent_id")]; ok {
+			channel["permission_overwrites"] = toAny(copyObjects(objects(parent, "permission_overwrites")))
+		}
+	}
+	s.assignTagIDs(channel)
+	s.channels[stringField(channel, "id")] = channel
+
+	return channel
+}
+
+// assignTagIDs gives new forum tags an ID, the same as Discord does when they are first saved.
+func (s *Server) assignTagIDs(channel Object) {
+	for _, tag := range objects(channel, "available_tags") {
+		if stringField(tag, "id") == "" {
+			tag["id"] = s.snowflakes.Next()
+		}
+	}
+}
+
+func (s *Server) removeChannel(channelID string) {
+	delete(s.channels, channelID)
+	for id, channel := range s.channels {
+		if isThread(channel) && stringField(channel, "parent_id") == channelID {
+			s.removeChannel(id)
+		}
+	}
+	for id, message := range s.messages {
+		if stringField(message, "channel_id") == channelID {
+			delete(s.messages, id)
+		}
+	}
+	for code, invite := range s.invites {
+		if channel, _ := invite["channel"].(Object); stringField(channel, "id") == channelID {
+			delete(s.invites, code)
+		}
+	}
+	for id, webhook := range s.webhooks {
+		if stringField(webhook, "channel_id") == channelID {
+			delete(s.webhooks, id)
+		}
+	}
+}
+
+func (s *Server) channel(w http.ResponseWriter, channelID string) (Object, bool) {
+	channel, ok := s.channels[channelID]
+	if !ok {
+		writeUnknown(w, discordgo.ErrCodeUnknownChannel, "Unknown Channel")
+	}
+
+	return channel, ok
+}
+
+func createGuildChannel(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.guild(w, params[0]); !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if stringField(body, "name") == "" || !s.validDefaultReaction(params[0], body) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	writeJSON(w, http.StatusCreated, s.newChannel(params[0], body))
+}
+
+// validDefaultReaction checks that a custom default reaction of a forum is an emoji of the server.
+func (s *Server) validDefaultReaction(guildID string, body Object) bool {
+	reaction, ok := body["default_reaction_emoji"].(Object)
+	if !ok || stringField(reaction, "emoji_id") == "" {
+		return true
+	}
+
+	return findByID(objects(s.guilds[guildID], "emojis"), stringField(reaction, "emoji_id")) != nil
+}
+
+func getChannel(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	channel, ok := s.channel(w, params[0])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, channel)
+}
+
+func editChannel(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	channel, ok := s.channel(w, params[0])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	delete(body, "id")
+	if !s.validDefaultReaction(stringField(channel, "guild_id"), body) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	if isThread(channel) && !editThread(w, channel, body) {
+		return
+	}
+	merge(channel, body)
+	s.assignTagIDs(channel)
+	writeJSON(w, http.StatusOK, channel)
+}
+
+func deleteChannel(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	channel, ok := s.channel(w, params[0])
+	if !ok {
+		return
+	}
+	s.removeChannel(params[0])
+	writeJSON(w, http.StatusOK, channel)
+}
+
+func setChannelPermission(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	channel, ok := s.channel(w, params[0])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	body["id"] = params[1]
+	for _, key := range []string{"allow", "deny"} {
+		if _, ok := body[key]; !ok {
+			body[key] = "0"
+		}
+	}
+
+	overwrites := objects(channel, "permission_overwrites")
+	if existing := findByID(overwrites, params[1]); existing != nil {
+		merge(existing, body)
+	} else {
+		overwrites = append(overwrites, body)
+	}
+	channel["permission_overwrites"] = toAny(overwrites)
+	writeNoContent(w)
+}
+
+func deleteChannelPermission(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	channel, ok := s.channel(w, params[0])
+	if !ok {
+		return
+	}
+	overwrites := objects(channel, "permission_overwrites")
+	for i, overwrite := range overwrites {
+		if stringField(overwrite, "id") == params[1] {
+			channel["permission_overwrites"] = toAny(append(overwrites[:i], overwrites[i+1:]...))
+			break
+		}
+	}
+	writeNoContent(w)
+}
+
+func getChannelInvites(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.channel(w, params[0]); !ok {
+		return
+	}
+	invites := []Object{}
+	for _, invite := range s.invites {
+		if channel, _ := invite["channel"].(Object); stringField(channel, "id") == params[0] {
+			invites = append(invites, invite)
+		}
+	}
+	writeJSON(w, http.StatusOK, invites)
+}
+
+func createChannelInvite(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	channel, ok := s.channel(w, params[0])
+	if !ok {
+		return
+	}
+	body := Object{}
+	if r.ContentLength != 0 && !decodeBody(w, r, &body) {
+		return
+	}
+
+	code := make([]byte, 8)
+	for i := range code {
+		code[i] = inviteCodeCharacters[rand.Intn(len(inviteCodeCharacters))]
+	}
+	invite := Object{
+		"code":       string(code),
+		"channel":    Object{"id": channel["id"], "name": channel["name"], "type": channel["type"]},
+		"inviter":    s.BotUser,
+		"max_age":    86400,
+		"max_uses":   0,
+		"temporary":  false,
+		"unique":     false,
+		"uses":       0,
+		"created_at": now(),
+	}
+	if guild, ok := s.guilds[stringField(channel, "guild_id")]; ok {
+		invite["guild"] = Object{"id": guild["id"], "name": guild["name"]}
+	}
+	merge(invite, body)
+	s.invites[string(code)] = invite
+	writeJSON(w, http.StatusOK, invite)
+}
+
+func (s *Server) invite(w http.ResponseWriter, code string) (Object, bool) {
+	invite, ok := s.invites[code]
+	if !ok {
+		writeUnknown(w, discordgo.ErrCodeUnknownInvite, "Unknown Invite")
+	}
+
+	return invite, ok
+}
+
+func getInvite(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	invite, ok := s.invite(w, params[0])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, invite)
+}
+
+func deleteInvite(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	invite, ok := s.invite(w, params[0])
+	if !ok {
+		return
+	}
+	delete(s.invites, params[0])
+	writeJSON(w, http.StatusOK, invite)
+}
+
+func copyObjects(list []Object) []Object {
+	copied := make([]Object, 0, len(list))
+	for _, o := range list {
+		c := Object{}
+		for k, v := range o {
+			c[k] = v
+		}
+		copied = append(copied, c)
+	}
+
+	return copied
+}
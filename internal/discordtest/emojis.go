@@ -0,0 +1,118 @@
+package discordtest
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+	"regexp"
+)
+
+// maxEmojiSize is the largest emoji image Discord accepts.
+const maxEmojiSize = 256 * 1024
+
+var emojiNamePattern = regexp.MustCompile(`^[A-Za-z0-9_]{2,32}$`)
+
+func (s *Server) emoji(w http.ResponseWriter, guildID string, emojiID string) (Object, bool) {
+	guild, ok := s.guild(w, guildID)
+	if !ok {
+		return nil, false
+	}
+	emoji := findByID(objects(guild, "emojis"), emojiID)
+	if emoji == nil {
+		writeUnknown(w, discordgo.ErrCodeUnknownEmoji, "Unknown Emoji")
+		return nil, false
+	}
+
+	return emoji, true
+}
+
+func getGuildEmojis(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	guild, ok := s.guild(w, params[0])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, guild["emojis"])
+}
+
+func getGuildEmoji(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	emoji, ok := s.emoji(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, emoji)
+}
+
+func createGuildEmoji(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	guild, ok := s.guild(w, params[0])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	image, format, ok := decodeImage(stringField(body, "image"))
+	if !emojiNamePattern.MatchString(stringField(body, "name")) || !ok {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	if len(image) > maxEmojiSize {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeFileUploadedExceedsTheMaximumSize, "File cannot be larger than 256.0 kb.")
+		return
+	}
+	roles, _ := body["roles"].([]any)
+	if roles == nil {
+		roles = []any{}
+	}
+	emoji := Object{
+		"id":             s.snowflakes.Next(),
+		"name":           body["name"],
+		"roles":          roles,
+		"user":           s.BotUser,
+		"require_colons": true,
+		"managed":        false,
+		"animated":       format == "image/gif",
+		"available":      true,
+	}
+	guild["emojis"] = append(guild["emojis"].([]any), emoji)
+	writeJSON(w, http.StatusCreated, emoji)
+}
+
+func editGuildEmoji(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	emoji, ok := s.emoji(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if name, ok := body["name"]; ok {
+		if str, _ := name.(string); !emojiNamePattern.MatchString(str) {
+			writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+			return
+		}
+		emoji["name"] = name
+	}
+	if roles, ok := body["roles"]; ok {
+		if roles == nil {
+			roles = []any{}
+		}
+		emoji["roles"] = roles
+	}
+	writeJSON(w, http.StatusOK, emoji)
+}
+
+func deleteGuildEmoji(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.emoji(w, params[0], params[1]); !ok {
+		return
+	}
+	guild := s.guilds[params[0]]
+	emojis := objects(guild, "emojis")
+	for i, emoji := range emojis {
+		if stringField(emoji, "id") == params[1] {
+			guild["emojis"] = toAny(append(emojis[:i], emojis[i+1:]...))
+			break
+		}
+	}
+	writeNoContent(w)
+}
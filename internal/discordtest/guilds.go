@@ -0,0 +1,552 @@
+package discordtest
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AddGuild creates a server owned by the bot user, with an @everyone role and a general text channel, and returns
+// its ID.
+func (s *Server) AddGuild(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return stringField(s.newGuild(Object{"name": name}), "id")
+}
+
+// AddMember adds a new user to a server and returns the user's ID.
+func (s *Server) AddMember(guildID string, username string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user := Object{
+		"id":            s.snowflakes.Next(),
+		"username":      username,
+		"discriminator": "0",
+		"avatar":        imageHash(username),
+		"bot":           false,
+	}
+	s.addMember(guildID, user)
+
+	return stringField(user, "id")
+}
+
+// ConnectVoice connects a member to a voice channel, as if they had joined it.
+func (s *Server) ConnectVoice(guildID string, userID string, channelID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.voiceStates[guildID+"/"+userID] = channelID
+}
+
+// AddGuildFeature turns on a feature of a server, such as COMMUNITY, that the bot cannot enable itself.
+func (s *Server) AddGuildFeature(guildID string, feature string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	setFeature(s.guilds[guildID], feature, true)
+}
+
+func (s *Server) newGuild(body Object) Object {
+	id := s.snowflakes.Next()
+	guild := Object{
+		"id":                            id,
+		"name":                          "",
+		"icon":                          nil,
+		"splash":                        nil,
+		"owner_id":                      s.BotUser["id"],
+		"region":                        "us-west",
+		"afk_channel_id":                nil,
+		"afk_timeout":                   300,
+		"verification_level":            0,
+		"default_message_notifications": 0,
+		"explicit_content_filter":       0,
+		"system_channel_id":             nil,
+		"features":                      []any{},
+		"emojis":                        []any{},
+		"stickers":                      []any{},
+		"roles": []any{
+			Object{
+				"id":          id,
+				"name":        "@everyone",
+				"color":       0,
+				"hoist":       false,
+				"position":    0,
+				"permissions": strconv.FormatInt(discordgo.PermissionViewChannel|discordgo.PermissionSendMessages, 10),
+				"managed":     false,
+				"mentionable": false,
+			},
+		},
+	}
+	roles := objects(body, "roles")
+	channels := objects(body, "channels")
+	delete(body, "roles")
+	delete(body, "channels")
+	merge(guild, body)
+	s.guilds[id] = guild
+
+	// Role and channel IDs in a create body are placeholders that the channels refer to.
+	placeholders := map[string]string{}
+	for i, role := range roles {
+		if i == 0 {
+			placeholders[stringField(role, "id")] = id
+			continue
+		}
+		created := s.newRole(guild, role)
+		placeholders[stringField(role, "id")] = stringField(created, "id")
+	}
+	if len(channels) == 0 {
+		channels = []Object{{"name": "general", "type": 0}}
+	}
+	for _, channel := range channels {
+		placeholder := stringField(channel, "id")
+		delete(channel, "id")
+		if parentID, ok := placeholders[stringField(channel, "parent_id")]; ok {
+			channel["parent_id"] = parentID
+		}
+		created := s.newChannel(id, channel)
+		if placeholder != "" {
+			placeholders[placeholder] = stringField(created, "id")
+		}
+		if guild["system_channel_id"] == nil && number(created["type"]) == float64(discordgo.ChannelTypeGuildText) {
+			guild["system_channel_id"] = created["id"]
+		}
+	}
+	s.addMember(id, s.BotUser)
+
+	return guild
+}
+
+func (s *Server) addMember(guildID string, user Object) {
+	s.users[stringField(user, "id")] = user
+	s.members[guildID] = append(s.members[guildID], Object{
+		"user":                         user,
+		"nick":                         nil,
+		"avatar":                       nil,
+		"roles":                        []any{},
+		"joined_at":                    now(),
+		"deaf":                         false,
+		"mute":                         false,
+		"pending":                      false,
+		"communication_disabled_until": nil,
+	})
+}
+
+func (s *Server) newRole(guild Object, body Object) Object {
+	role := Object{
+		"id":          s.snowflakes.Next(),
+		"name":        "new role",
+		"color":       0,
+		"hoist":       false,
+		"position":    1,
+		"permissions": "0",
+		"managed":     false,
+		"mentionable": false,
+	}
+	delete(body, "id")
+	merge(role, body)
+	// Discord also moves every other role up by one. That is skipped here so parallel tests can rely on the
+	// positions of the roles they created.
+	role["position"] = 1
+	guild["roles"] = append(guild["roles"].([]any), role)
+
+	return role
+}
+
+func (s *Server) guild(w http.ResponseWriter, guildID string) (Object, bool) {
+	guild, ok := s.guilds[guildID]
+	if !ok {
+		writeUnknown(w, discordgo.ErrCodeUnknownGuild, "Unknown Guild")
+	}
+
+	return guild, ok
+}
+
+func getCurrentUser(s *Server, w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, s.BotUser)
+}
+
+func getCurrentUserGuilds(s *Server, w http.ResponseWriter, _ *http.Request, _ []string) {
+	ids := make([]string, 0, len(s.guilds))
+	for id := range s.guilds {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	guilds := make([]Object, 0, len(ids))
+	for _, id := range ids {
+		guild := s.guilds[id]
+		guilds = append(guilds, Object{
+			"id":          guild["id"],
+			"name":        guild["name"],
+			"icon":        guild["icon"],
+			"owner":       guild["owner_id"] == s.BotUser["id"],
+			"permissions": strconv.FormatInt(discordgo.PermissionAll, 10),
+			"features":    guild["features"],
+		})
+	}
+	writeJSON(w, http.StatusOK, guilds)
+}
+
+func createGuild(s *Server, w http.ResponseWriter, r *http.Request, _ []string) {
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if stringField(body, "name") == "" {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	writeJSON(w, http.StatusCreated, s.newGuild(body))
+}
+
+func getGuild(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	guild, ok := s.guild(w, params[0])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, guild)
+}
+
+func editGuild(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	guild, ok := s.guild(w, params[0])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	merge(guild, body)
+	writeJSON(w, http.StatusOK, guild)
+}
+
+func deleteGuild(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	guildID := params[0]
+	if _, ok := s.guild(w, guildID); !ok {
+		return
+	}
+	for id, channel := range s.channels {
+		if stringField(channel, "guild_id") == guildID {
+			s.removeChannel(id)
+		}
+	}
+	delete(s.guilds, guildID)
+	delete(s.members, guildID)
+	delete(s.bans, guildID)
+	for id, event := range s.scheduledEvents {
+		if stringField(event, "guild_id") == guildID {
+			delete(s.scheduledEvents, id)
+			delete(s.eventUsers, id)
+		}
+	}
+	delete(s.welcomeScreens, guildID)
+	delete(s.onboardings, guildID)
+	for code, template := range s.templates {
+		if stringField(template, "source_guild_id") == guildID {
+			delete(s.templates, code)
+		}
+	}
+	for id, rule := range s.autoModRules {
+		if stringField(rule, "guild_id") == guildID {
+			delete(s.autoModRules, id)
+		}
+	}
+	for id, command := range s.commands {
+		if stringField(command, "guild_id") == guildID {
+			delete(s.commands, id)
+		}
+	}
+	writeNoContent(w)
+}
+
+func getGuildChannels(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	guildID := params[0]
+	if _, ok := s.guild(w, guildID); !ok {
+		return
+	}
+	channels := []Object{}
+	for _, channel := range s.channels {
+		if stringField(channel, "guild_id") == guildID {
+			channels = append(channels, channel)
+		}
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		return number(channels[i]["position"]) < number(channels[j]["position"])
+	})
+	writeJSON(w, http.StatusOK, channels)
+}
+
+func getGuildRoles(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	guild, ok := s.guild(w, params[0])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, guild["roles"])
+}
+
+func createGuildRole(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	guild, ok := s.guild(w, params[0])
+	if !ok {
+		return
+	}
+	body := Object{}
+	if r.ContentLength != 0 && !decodeBody(w, r, &body) {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.newRole(guild, body))
+}
+
+func reorderGuildRoles(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	guild, ok := s.guild(w, params[0])
+	if !ok {
+		return
+	}
+	var body []Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	roles := objects(guild, "roles")
+	for _, change := range body {
+		role := findByID(roles, stringField(change, "id"))
+		if role == nil {
+			writeUnknown(w, discordgo.ErrCodeUnknownRole, "Unknown Role")
+			return
+		}
+		role["position"] = change["position"]
+	}
+	writeJSON(w, http.StatusOK, roles)
+}
+
+func editGuildRole(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	guild, ok := s.guild(w, params[0])
+	if !ok {
+		return
+	}
+	role := findByID(objects(guild, "roles"), params[1])
+	if role == nil {
+		writeUnknown(w, discordgo.ErrCodeUnknownRole, "Unknown Role")
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	delete(body, "id")
+	merge(role, body)
+	writeJSON(w, http.StatusOK, role)
+}
+
+func deleteGuildRole(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	guild, ok := s.guild(w, params[0])
+	if !ok {
+		return
+	}
+	roles := objects(guild, "roles")
+	for i, role := range roles {
+		if stringField(role, "id") == params[1] {
+			guild["roles"] = toAny(append(roles[:i], roles[i+1:]...))
+			for _, member := range s.members[params[0]] {
+				member["roles"] = withoutRole(member["roles"], params[1])
+			}
+			writeNoContent(w)
+			return
+		}
+	}
+	writeUnknown(w, discordgo.ErrCodeUnknownRole, "Unknown Role")
+}
+
+func (s *Server) member(w http.ResponseWriter, guildID string, userID string) (Object, bool) {
+	if _, ok := s.guild(w, guildID); !ok {
+		return nil, false
+	}
+	for _, member := range s.members[guildID] {
+		if user, _ := member["user"].(Object); stringField(user, "id") == userID {
+			// Like Discord, a timeout is no longer returned once it has ended.
+			if until, err := time.Parse(time.RFC3339, stringField(member, "communication_disabled_until")); err == nil && !until.After(time.Now()) {
+				member["communication_disabled_until"] = nil
+			}
+			return member, true
+		}
+	}
+	writeUnknown(w, discordgo.ErrCodeUnknownMember, "Unknown Member")
+
+	return nil, false
+}
+
+func getGuildMember(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	member, ok := s.member(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, member)
+}
+
+func editGuildMember(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	member, ok := s.member(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	// Like Discord, voice changes are only possible while the member is connected to voice.
+	voiceState := params[0] + "/" + params[1]
+	for _, key := range []string{"mute", "deaf", "channel_id"} {
+		if _, ok := body[key]; !ok {
+			continue
+		}
+		if _, connected := s.voiceStates[voiceState]; !connected {
+			writeError(w, http.StatusBadRequest, discordgo.ErrCodeTargetIsNotConnectedToVoice, "Target user is not connected to voice")
+			return
+		}
+	}
+	if channelID, ok := body["channel_id"]; ok {
+		if channelID == nil {
+			delete(s.voiceStates, voiceState)
+		} else {
+			channel, ok := s.channel(w, stringField(body, "channel_id"))
+			if !ok {
+				return
+			}
+			s.voiceStates[voiceState] = stringField(channel, "id")
+		}
+		delete(body, "channel_id")
+	}
+	if nick, ok := body["nick"]; ok && nick == "" {
+		body["nick"] = nil
+	}
+	delete(body, "user")
+	merge(member, body)
+	writeJSON(w, http.StatusOK, member)
+}
+
+func addGuildMemberRole(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	member, ok := s.member(w, params[0], params[1])
+	if !ok || !s.assignableRole(w, params[0], params[2]) {
+		return
+	}
+	roles, _ := member["roles"].([]any)
+	for _, id := range roles {
+		if id == params[2] {
+			writeNoContent(w)
+			return
+		}
+	}
+	member["roles"] = append(roles, params[2])
+	writeNoContent(w)
+}
+
+func removeGuildMemberRole(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	member, ok := s.member(w, params[0], params[1])
+	if !ok || !s.assignableRole(w, params[0], params[2]) {
+		return
+	}
+	member["roles"] = withoutRole(member["roles"], params[2])
+	writeNoContent(w)
+}
+
+// assignableRole checks that a role exists and, like Discord, that it is not managed by an integration.
+func (s *Server) assignableRole(w http.ResponseWriter, guildID string, roleID string) bool {
+	role := findByID(objects(s.guilds[guildID], "roles"), roleID)
+	if role == nil {
+		writeUnknown(w, discordgo.ErrCodeUnknownRole, "Unknown Role")
+		return false
+	}
+	if managed, _ := role["managed"].(bool); managed {
+		writeError(w, http.StatusForbidden, discordgo.ErrCodeMissingPermissions, "Missing Permissions")
+		return false
+	}
+
+	return true
+}
+
+func withoutRole(v any, roleID string) []any {
+	roles, _ := v.([]any)
+	kept := []any{}
+	for _, id := range roles {
+		if id != roleID {
+			kept = append(kept, id)
+		}
+	}
+
+	return kept
+}
+
+func searchGuildMembers(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.guild(w, params[0]); !ok {
+		return
+	}
+	query := strings.ToLower(r.URL.Query().Get("query"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 1
+	}
+	members := []Object{}
+	for _, member := range s.members[params[0]] {
+		user, _ := member["user"].(Object)
+		nick, _ := member["nick"].(string)
+		if strings.HasPrefix(strings.ToLower(stringField(user, "username")), query) ||
+			(nick != "" && strings.HasPrefix(strings.ToLower(nick), query)) {
+			members = append(members, member)
+		}
+		if len(members) == limit {
+			break
+		}
+	}
+	writeJSON(w, http.StatusOK, members)
+}
+
+func findByID(list []Object, id string) Object {
+	for _, o := range list {
+		if stringField(o, "id") == id {
+			return o
+		}
+	}
+
+	return nil
+}
+
+func hasFeature(guild Object, feature string) bool {
+	features, _ := guild["features"].([]any)
+	for _, f := range features {
+		if f == feature {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setFeature adds a feature to a server, or removes it.
+func setFeature(guild Object, feature string, enabled bool) {
+	features := []any{}
+	current, _ := guild["features"].([]any)
+	for _, f := range current {
+		if f != feature {
+			features = append(features, f)
+		}
+	}
+	if enabled {
+		features = append(features, feature)
+	}
+	guild["features"] = features
+}
+
+// number reads a JSON number that may have been stored either by the server or decoded from a request.
+func number(v any) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case int:
+		return float64(n)
+	default:
+		return 0
+	}
+}
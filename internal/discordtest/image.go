@@ -0,0 +1,43 @@
+package discordtest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+)
+
+const imagePath = "/terraform.png"
+
+// testImage is a 16x16 PNG in Terraform purple.
+var testImage = func() []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	for x := 0; x < 16; x++ {
+		for y := 0; y < 16; y++ {
+			img.Set(x, y, color.RGBA{R: 0x84, G: 0x4f, B: 0xba, A: 0xff})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		panic(err)
+	}
+
+	return buf.Bytes()
+}()
+
+// decodeImage returns the content and MIME type of a base64 image data URI, the way images are uploaded.
+func decodeImage(dataURI string) ([]byte, string, bool) {
+	header, data, ok := strings.Cut(dataURI, ",")
+	format, isBase64 := strings.CutSuffix(strings.TrimPrefix(header, "data:"), ";base64")
+	if !ok || !strings.HasPrefix(header, "data:") || !isBase64 {
+		return nil, "", false
+	}
+	image, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, "", false
+	}
+
+	return image, format, true
+}
@@ -0,0 +1,108 @@
+package discordtest
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+)
+
+func (s *Server) message(w http.ResponseWriter, channelID string, messageID string) (Object, bool) {
+	if _, ok := s.channel(w, channelID); !ok {
+		return nil, false
+	}
+	message, ok := s.messages[messageID]
+	if !ok || stringField(message, "channel_id") != channelID {
+		writeUnknown(w, discordgo.ErrCodeUnknownMessage, "Unknown Message")
+		return nil, false
+	}
+
+	return message, true
+}
+
+func createMessage(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	channel, ok := s.channel(w, params[0])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if stringField(body, "content") == "" && len(objects(body, "embeds")) == 0 {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeCannotSendEmptyMessage, "Cannot send an empty message")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.newMessage(channel, s.snowflakes.Next(), body))
+}
+
+func (s *Server) newMessage(channel Object, id string, body Object) Object {
+	message := Object{
+		"id":               id,
+		"channel_id":       channel["id"],
+		"guild_id":         channel["guild_id"],
+		"author":           s.BotUser,
+		"content":          "",
+		"timestamp":        now(),
+		"edited_timestamp": nil,
+		"tts":              false,
+		"mention_everyone": false,
+		"mentions":         []any{},
+		"attachments":      []any{},
+		"embeds":           []any{},
+		"pinned":           false,
+		"type":             0,
+	}
+	delete(body, "id")
+	merge(message, body)
+	s.messages[id] = message
+
+	return message
+}
+
+func getMessage(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	message, ok := s.message(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, message)
+}
+
+func editMessage(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	message, ok := s.message(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	delete(body, "id")
+	merge(message, body)
+	message["edited_timestamp"] = now()
+	writeJSON(w, http.StatusOK, message)
+}
+
+func deleteMessage(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.message(w, params[0], params[1]); !ok {
+		return
+	}
+	delete(s.messages, params[1])
+	writeNoContent(w)
+}
+
+func pinMessage(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	message, ok := s.message(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	message["pinned"] = true
+	writeNoContent(w)
+}
+
+func unpinMessage(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	message, ok := s.message(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	message["pinned"] = false
+	writeNoContent(w)
+}
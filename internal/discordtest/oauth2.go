@@ -0,0 +1,72 @@
+package discordtest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// BearerTokens returns how many Bearer tokens have been issued.
+func (s *Server) BearerTokens() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.bearerTokens)
+}
+
+// authorized accepts any bot token and the Bearer tokens issued by the server that have not expired.
+func (s *Server) authorized(authorization string) bool {
+	if token, ok := strings.CutPrefix(authorization, "Bearer "); ok {
+		expiry, issued := s.bearerTokens[token]
+		return issued && time.Now().Before(expiry)
+	}
+
+	if s.BotToken != "" {
+		return authorization == s.BotToken
+	}
+
+	return authorization != ""
+}
+
+// issueToken implements the client credentials grant of POST /oauth2/token.
+func (s *Server) issueToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, 0, "405: Method Not Allowed")
+		return
+	}
+	clientID, secret, ok := r.BasicAuth()
+	if !ok || clientID != s.ClientID || secret != s.ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, Object{"error": "invalid_client"})
+		return
+	}
+	if r.PostFormValue("grant_type") != "client_credentials" {
+		writeJSON(w, http.StatusBadRequest, Object{"error": "unsupported_grant_type"})
+		return
+	}
+
+	raw := make([]byte, 16)
+	_, _ = rand.Read(raw)
+	token := hex.EncodeToString(raw)
+	s.bearerTokens[token] = time.Now().Add(s.TokenLifetime)
+	writeJSON(w, http.StatusOK, Object{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   int64(s.TokenLifetime.Seconds()),
+		"scope":        r.PostFormValue("scope"),
+	})
+}
+
+func getCurrentApplication(s *Server, w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, s.Application)
+}
+
+// getCurrentAuthorization describes a Bearer token. Tokens from the client credentials grant have no user.
+func getCurrentAuthorization(s *Server, w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeJSON(w, http.StatusOK, Object{
+		"application": s.Application,
+		"scopes":      []string{"identify", "applications.commands.update"},
+		"expires":     time.Now().Add(s.TokenLifetime).UTC().Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,215 @@
+package discordtest
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+	"strconv"
+)
+
+// The onboarding limits Discord enforces. Enabled onboarding needs minDefaultChannels channels, minSendableChannels
+// of which @everyone can send messages in.
+const (
+	maxOnboardingPrompts = 15
+	maxPromptOptions     = 50
+	minDefaultChannels   = 7
+	minSendableChannels  = 5
+)
+
+func (s *Server) onboarding(guildID string) Object {
+	onboarding, ok := s.onboardings[guildID]
+	if !ok {
+		onboarding = Object{
+			"guild_id":            guildID,
+			"prompts":             []any{},
+			"default_channel_ids": []any{},
+			"enabled":             false,
+			"mode":                0,
+		}
+		s.onboardings[guildID] = onboarding
+	}
+
+	return onboarding
+}
+
+// guildChannel reports whether channelID is a channel of the server.
+func (s *Server) guildChannel(guildID string, channelID any) bool {
+	id, _ := channelID.(string)
+	channel, ok := s.channels[id]
+
+	return ok && stringField(channel, "guild_id") == guildID
+}
+
+// everyoneCanSend reports whether @everyone can view and send messages in a channel, from the permissions of the
+// @everyone role and its overwrite in the channel.
+func everyoneCanSend(guild Object, channel Object) bool {
+	guildID := stringField(guild, "id")
+	var permissions int64
+	if everyone := findByID(objects(guild, "roles"), guildID); everyone != nil {
+		permissions, _ = strconv.ParseInt(stringField(everyone, "permissions"), 10, 64)
+	}
+	if overwrite := findByID(objects(channel, "permission_overwrites"), guildID); overwrite != nil {
+		deny, _ := strconv.ParseInt(stringField(overwrite, "deny"), 10, 64)
+		allow, _ := strconv.ParseInt(stringField(overwrite, "allow"), 10, 64)
+		permissions = permissions&^deny | allow
+	}
+	send := int64(discordgo.PermissionViewChannel | discordgo.PermissionSendMessages)
+
+	return permissions&send == send
+}
+
+// validOnboarding checks the prompts and channels of an onboarding, and that enabled onboarding has the default
+// channels Discord needs. In advanced mode the channels of the prompt options count too.
+func (s *Server) validOnboarding(guild Object, onboarding Object) bool {
+	guildID := stringField(guild, "id")
+	channels := map[string]bool{}
+	defaults, _ := onboarding["default_channel_ids"].([]any)
+	for _, channelID := range defaults {
+		if !s.guildChannel(guildID, channelID) {
+			return false
+		}
+		channels[channelID.(string)] = true
+	}
+
+	prompts := objects(onboarding, "prompts")
+	if len(prompts) > maxOnboardingPrompts {
+		return false
+	}
+	for _, prompt := range prompts {
+		options := objects(prompt, "options")
+		if title := len(stringField(prompt, "title")); title < 1 || title > 100 || len(options) < 1 || len(options) > maxPromptOptions {
+			return false
+		}
+		for _, option := range options {
+			optionChannels, _ := option["channel_ids"].([]any)
+			optionRoles, _ := option["role_ids"].([]any)
+			if title := len(stringField(option, "title")); title < 1 || title > 50 || len(optionChannels)+len(optionRoles) == 0 {
+				return false
+			}
+			for _, channelID := range optionChannels {
+				if !s.guildChannel(guildID, channelID) {
+					return false
+				}
+				if number(onboarding["mode"]) == float64(discordgo.GuildOnboardingModeAdvanced) {
+					channels[channelID.(string)] = true
+				}
+			}
+			for _, roleID := range optionRoles {
+				id, _ := roleID.(string)
+				if findByID(objects(guild, "roles"), id) == nil {
+					return false
+				}
+			}
+		}
+	}
+
+	if enabled, _ := onboarding["enabled"].(bool); !enabled {
+		return true
+	}
+	sendable := 0
+	for channelID := range channels {
+		if everyoneCanSend(guild, s.channels[channelID]) {
+			sendable++
+		}
+	}
+
+	return len(channels) >= minDefaultChannels && sendable >= minSendableChannels
+}
+
+// normalizeOnboardingPrompts gives new prompts and options an ID, and turns the emoji fields of the options into
+// the emoji object Discord returns.
+func (s *Server) normalizeOnboardingPrompts(prompts []Object) {
+	for _, prompt := range prompts {
+		if id := stringField(prompt, "id"); id == "" || id == "0" {
+			prompt["id"] = s.snowflakes.Next()
+		}
+		for _, option := range objects(prompt, "options") {
+			if id := stringField(option, "id"); id == "" || id == "0" {
+				option["id"] = s.snowflakes.Next()
+			}
+			var emoji any
+			if option["emoji_id"] != nil || option["emoji_name"] != nil {
+				animated, _ := option["emoji_animated"].(bool)
+				emoji = Object{"id": option["emoji_id"], "name": option["emoji_name"], "animated": animated}
+			}
+			option["emoji"] = emoji
+			delete(option, "emoji_id")
+			delete(option, "emoji_name")
+			delete(option, "emoji_animated")
+			for _, key := range []string{"channel_ids", "role_ids"} {
+				if option[key] == nil {
+					option[key] = []any{}
+				}
+			}
+			if _, ok := option["description"]; !ok {
+				option["description"] = nil
+			}
+		}
+	}
+}
+
+// uniqueOnboardingIDs checks that no two prompts or options are sent with the same ID.
+func uniqueOnboardingIDs(prompts []Object) bool {
+	seen := map[string]bool{}
+	for _, prompt := range prompts {
+		ids := []string{stringField(prompt, "id")}
+		for _, option := range objects(prompt, "options") {
+			ids = append(ids, stringField(option, "id"))
+		}
+		for _, id := range ids {
+			if id == "" {
+				continue
+			}
+			if seen[id] {
+				return false
+			}
+			seen[id] = true
+		}
+	}
+
+	return true
+}
+
+func getGuildOnboarding(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.guild(w, params[0]); !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.onboarding(params[0]))
+}
+
+func editGuildOnboarding(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	guild, ok := s.guild(w, params[0])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if !hasFeature(guild, "COMMUNITY") {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Onboarding is only available to community servers")
+		return
+	}
+	edited := Object{}
+	merge(edited, s.onboarding(params[0]))
+	merge(edited, body)
+	if edited["default_channel_ids"] == nil {
+		edited["default_channel_ids"] = []any{}
+	}
+	if edited["prompts"] == nil {
+		edited["prompts"] = []any{}
+	}
+	prompts := objects(edited, "prompts")
+	if !uniqueOnboardingIDs(prompts) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	s.normalizeOnboardingPrompts(prompts)
+	edited["prompts"] = toAny(prompts)
+	edited["guild_id"] = params[0]
+	if !s.validOnboarding(guild, edited) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	s.onboardings[params[0]] = edited
+	writeJSON(w, http.StatusOK, edited)
+}
@@ -0,0 +1,217 @@
+package discordtest
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// SubscribeScheduledEvent marks a user as interested in a scheduled event, as if they had clicked on it.
+func (s *Server) SubscribeScheduledEvent(eventID string, userID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.eventUsers[eventID] = append(s.eventUsers[eventID], userID)
+}
+
+func (s *Server) scheduledEvent(w http.ResponseWriter, guildID string, eventID string) (Object, bool) {
+	if _, ok := s.guild(w, guildID); !ok {
+		return nil, false
+	}
+	event, ok := s.scheduledEvents[eventID]
+	if !ok || stringField(event, "guild_id") != guildID {
+		writeUnknown(w, discordgo.ErrCodeUnknownGuildScheduledEvent, "Unknown Guild Scheduled Event")
+		return nil, false
+	}
+
+	return event, true
+}
+
+// validScheduledEvent checks the fields Discord requires for each entity type, and the recurrence rules it supports.
+func (s *Server) validScheduledEvent(guildID string, event Object) bool {
+	if name := len(stringField(event, "name")); name < 1 || name > 100 {
+		return false
+	}
+	if number(event["privacy_level"]) != float64(discordgo.GuildScheduledEventPrivacyLevelGuildOnly) {
+		return false
+	}
+	start, err := time.Parse(time.RFC3339, stringField(event, "scheduled_start_time"))
+	if err != nil {
+		return false
+	}
+	if end := stringField(event, "scheduled_end_time"); end != "" {
+		if endTime, err := time.Parse(time.RFC3339, end); err != nil || !endTime.After(start) {
+			return false
+		}
+	}
+
+	switch entityType := number(event["entity_type"]); entityType {
+	case float64(discordgo.GuildScheduledEventEntityTypeStageInstance), float64(discordgo.GuildScheduledEventEntityTypeVoice):
+		channelType := float64(discordgo.ChannelTypeGuildVoice)
+		if entityType == float64(discordgo.GuildScheduledEventEntityTypeStageInstance) {
+			channelType = float64(discordgo.ChannelTypeGuildStageVoice)
+		}
+		channel, ok := s.channels[stringField(event, "channel_id")]
+		if !ok || stringField(channel, "guild_id") != guildID || number(channel["type"]) != channelType || event["entity_metadata"] != nil {
+			return false
+		}
+	case float64(discordgo.GuildScheduledEventEntityTypeExternal):
+		metadata, _ := event["entity_metadata"].(Object)
+		if event["channel_id"] != nil || stringField(metadata, "location") == "" || stringField(event, "scheduled_end_time") == "" {
+			return false
+		}
+	default:
+		return false
+	}
+
+	rule, _ := event["recurrence_rule"].(Object)
+	if rule == nil {
+		return true
+	}
+	ruleStart, err := time.Parse(time.RFC3339, stringField(rule, "start"))
+	if err != nil || !ruleStart.Equal(start) {
+		return false
+	}
+	interval := number(rule["interval"])
+	byWeekday, _ := rule["by_weekday"].([]any)
+	byNWeekday, _ := rule["by_n_weekday"].([]any)
+	byMonth, _ := rule["by_month"].([]any)
+	byMonthDay, _ := rule["by_month_day"].([]any)
+	switch number(rule["frequency"]) {
+	case 0:
+		return interval == 1 && len(byMonth) == 1 && len(byMonthDay) == 1 && len(byWeekday) == 0 && len(byNWeekday) == 0
+	case 1:
+		return interval == 1 && len(byNWeekday) == 1 && len(byWeekday) == 0 && len(byMonth) == 0
+	case 2:
+		return (interval == 1 || interval == 2) && len(byWeekday) == 1 && len(byNWeekday) == 0 && len(byMonth) == 0
+	case 3:
+		return interval == 1 && len(byNWeekday) == 0 && len(byMonth) == 0
+	}
+
+	return false
+}
+
+func createGuildScheduledEvent(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.guild(w, params[0]); !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	event := Object{
+		"id":                 s.snowflakes.Next(),
+		"guild_id":           params[0],
+		"channel_id":         nil,
+		"creator_id":         s.BotUser["id"],
+		"creator":            s.BotUser,
+		"description":        nil,
+		"scheduled_end_time": nil,
+		"entity_id":          nil,
+		"entity_metadata":    nil,
+		"user_count":         0,
+		"image":              nil,
+		"recurrence_rule":    nil,
+	}
+	delete(body, "status")
+	merge(event, body)
+	event["status"] = int(discordgo.GuildScheduledEventStatusScheduled)
+	start, _ := time.Parse(time.RFC3339, stringField(event, "scheduled_start_time"))
+	if !s.validScheduledEvent(params[0], event) || !start.After(time.Now()) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	s.scheduledEvents[stringField(event, "id")] = event
+	writeJSON(w, http.StatusOK, event)
+}
+
+func getGuildScheduledEvent(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	event, ok := s.scheduledEvent(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	event["user_count"] = len(s.eventUsers[params[1]])
+	writeJSON(w, http.StatusOK, event)
+}
+
+// scheduledEventTransitions are the status changes Discord allows, from the current status to the new one.
+var scheduledEventTransitions = map[float64][]float64{
+	float64(discordgo.GuildScheduledEventStatusScheduled): {float64(discordgo.GuildScheduledEventStatusActive), float64(discordgo.GuildScheduledEventStatusCanceled)},
+	float64(discordgo.GuildScheduledEventStatusActive):    {float64(discordgo.GuildScheduledEventStatusCompleted)},
+}
+
+func editGuildScheduledEvent(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	event, ok := s.scheduledEvent(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	current := number(event["status"])
+	if _, ok := scheduledEventTransitions[current]; !ok {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Cannot edit a finished event")
+		return
+	}
+	if status, ok := body["status"]; ok && number(status) != current {
+		allowed := false
+		for _, next := range scheduledEventTransitions[current] {
+			allowed = allowed || number(status) == next
+		}
+		if !allowed {
+			writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+			return
+		}
+	}
+	edited := Object{}
+	merge(edited, event)
+	merge(edited, body)
+	if !s.validScheduledEvent(params[0], edited) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	merge(event, edited)
+	writeJSON(w, http.StatusOK, event)
+}
+
+func deleteGuildScheduledEvent(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.scheduledEvent(w, params[0], params[1]); !ok {
+		return
+	}
+	delete(s.scheduledEvents, params[1])
+	delete(s.eventUsers, params[1])
+	writeNoContent(w)
+}
+
+func getGuildScheduledEventUsers(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.scheduledEvent(w, params[0], params[1]); !ok {
+		return
+	}
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 100
+	}
+	after, _ := strconv.ParseInt(r.URL.Query().Get("after"), 10, 64)
+
+	userIDs := []int64{}
+	for _, userID := range s.eventUsers[params[1]] {
+		if id, _ := strconv.ParseInt(userID, 10, 64); id > after {
+			userIDs = append(userIDs, id)
+		}
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+	if len(userIDs) > limit {
+		userIDs = userIDs[:limit]
+	}
+	users := []Object{}
+	for _, id := range userIDs {
+		users = append(users, Object{
+			"guild_scheduled_event_id": params[1],
+			"user":                     s.users[strconv.FormatInt(id, 10)],
+		})
+	}
+	writeJSON(w, http.StatusOK, users)
+}
@@ -0,0 +1,420 @@
+// Package discordtest provides an in-memory stand-in for the Discord REST API. It lets the provider acceptance tests
+// run without a bot token or a real server.
+package discordtest
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Object is a JSON object as it is sent to and returned by the API.
+type Object = map[string]any
+
+type handlerFunc func(s *Server, w http.ResponseWriter, r *http.Request, params []string)
+
+type route struct {
+	method  string
+	pattern []string
+	handler handlerFunc
+}
+
+// Server is an in-memory Discord API. All state is lost when the server is closed.
+type Server struct {
+	*httptest.Server
+
+	// BotUser is the user that owns every token sent to the server.
+	BotUser Object
+	// Application is the application of BotUser.
+	Application Object
+	// BotToken is the only bot token accepted when set. Any bot token is accepted otherwise.
+	BotToken string
+	// RetryAfter is the delay returned with rate limited responses.
+	RetryAfter time.Duration
+	// ClientID and ClientSecret are the credentials accepted by the OAuth2 client credentials grant.
+	ClientID     string
+	ClientSecret string
+	// TokenLifetime is how long issued Bearer tokens are valid for.
+	TokenLifetime time.Duration
+
+	mu         sync.Mutex
+	routes     []route
+	snowflakes *snowflakeGenerator
+	guilds     map[string]Object
+	channels   map[string]Object
+	members    map[string][]Object
+	// users are all the users that have been a member of a server, by ID.
+	users map[string]Object
+	// voiceStates maps "<guild id>/<user id>" to the voice channel the member is connected to.
+	voiceStates map[string]string
+	// bans maps server IDs to the bans of the server by user ID.
+	bans     map[string]map[string]Object
+	messages map[string]Object
+	invites  map[string]Object
+	webhooks map[string]Object
+	// scheduledEvents are all scheduled events by ID, and eventUsers the IDs of the users subscribed to each.
+	scheduledEvents map[string]Object
+	eventUsers      map[string][]string
+	// autoModRules are all AutoMod rules by ID.
+	autoModRules map[string]Object
+	// welcomeScreens maps server IDs to their welcome screen.
+	welcomeScreens map[string]Object
+	// onboardings maps server IDs to their onboarding.
+	onboardings map[string]Object
+	// templates are all server templates by code.
+	templates map[string]Object
+	// commands are all application commands by ID, global ones without a guild_id.
+	commands  map[string]Object
+	rateLimit int
+	// bearerTokens maps the issued Bearer tokens to when they expire.
+	bearerTokens map[string]time.Time
+}
+
+// NewServer starts a new server. Callers should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		RetryAfter:  50 * time.Millisecond,
+		snowflakes:  &snowflakeGenerator{},
+		guilds:      map[string]Object{},
+		channels:    map[string]Object{},
+		members:     map[string][]Object{},
+		users:       map[string]Object{},
+		voiceStates: map[string]string{},
+		bans:        map[string]map[string]Object{},
+		messages:    map[string]Object{},
+		invites:     map[string]Object{},
+		webhooks:    map[string]Object{},
+
+		scheduledEvents: map[string]Object{},
+		eventUsers:      map[string][]string{},
+		autoModRules:    map[string]Object{},
+		welcomeScreens:  map[string]Object{},
+		onboardings:     map[string]Object{},
+		templates:       map[string]Object{},
+		commands:        map[string]Object{},
+
+		ClientSecret:  "discordtest-secret",
+		TokenLifetime: 7 * 24 * time.Hour,
+		bearerTokens:  map[string]time.Time{},
+	}
+	s.BotUser = Object{
+		"id":            s.snowflakes.Next(),
+		"username":      "terraform",
+		"discriminator": "0",
+		"avatar":        nil,
+		"bot":           true,
+	}
+	s.ClientID = s.BotUser["id"].(string)
+	s.Application = Object{
+		"id":          s.ClientID,
+		"name":        "terraform",
+		"description": "",
+		"bot_public":  false,
+		"owner":       Object{"id": s.snowflakes.Next(), "username": "owner", "discriminator": "0"},
+		// Limited presence and message content intents, as granted to bots in less than 100 servers.
+		"flags": 1<<13 | 1<<19,
+	}
+	s.registerRoutes()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// ImageURL returns the URL of a small PNG served without authentication, for attributes that download an image.
+func (s *Server) ImageURL() string {
+	return s.URL + imagePath
+}
+
+// RateLimitNext makes the next n requests fail with a 429 response.
+func (s *Server) RateLimitNext(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rateLimit = n
+}
+
+func (s *Server) handle(method string, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:  method,
+		pattern: strings.Split(pattern, "/"),
+		handler: handler,
+	})
+}
+
+func (s *Server) registerRoutes() {
+	s.handle("GET", "oauth2/@me", getCurrentAuthorization)
+	s.handle("GET", "oauth2/applications/@me", getCurrentApplication)
+	s.handle("GET", "applications/:application/commands", getApplicationCommands)
+	s.handle("POST", "applications/:application/commands", createApplicationCommand)
+	s.handle("PUT", "applications/:application/commands", overwriteApplicationCommands)
+	s.handle("GET", "applications/:application/commands/:command", getApplicationCommand)
+	s.handle("PATCH", "applications/:application/commands/:command", editApplicationCommand)
+	s.handle("DELETE", "applications/:application/commands/:command", deleteApplicationCommand)
+	s.handle("GET", "applications/:application/guilds/:guild/commands", getApplicationCommands)
+	s.handle("POST", "applications/:application/guilds/:guild/commands", createApplicationCommand)
+	s.handle("PUT", "applications/:application/guilds/:guild/commands", overwriteApplicationCommands)
+	s.handle("GET", "applications/:application/guilds/:guild/commands/:command", getApplicationCommand)
+	s.handle("PATCH", "applications/:application/guilds/:guild/commands/:command", editApplicationCommand)
+	s.handle("DELETE", "applications/:application/guilds/:guild/commands/:command", deleteApplicationCommand)
+	s.handle("GET", "users/@me", getCurrentUser)
+	s.handle("GET", "users/@me/guilds", getCurrentUserGuilds)
+
+	s.handle("POST", "guilds", createGuild)
+	s.handle("GET", "guilds/templates/:template", getTemplate)
+	s.handle("POST", "guilds/templates/:template", createGuildFromTemplate)
+	s.handle("GET", "guilds/:guild", getGuild)
+	s.handle("PATCH", "guilds/:guild", editGuild)
+	s.handle("DELETE", "guilds/:guild", deleteGuild)
+	s.handle("GET", "guilds/:guild/channels", getGuildChannels)
+	s.handle("POST", "guilds/:guild/channels", createGuildChannel)
+	s.handle("GET", "guilds/:guild/roles", getGuildRoles)
+	s.handle("POST", "guilds/:guild/roles", createGuildRole)
+	s.handle("PATCH", "guilds/:guild/roles", reorderGuildRoles)
+	s.handle("PATCH", "guilds/:guild/roles/:role", editGuildRole)
+	s.handle("DELETE", "guilds/:guild/roles/:role", deleteGuildRole)
+	s.handle("GET", "guilds/:guild/members/search", searchGuildMembers)
+	s.handle("GET", "guilds/:guild/members/:user", getGuildMember)
+	s.handle("PATCH", "guilds/:guild/members/:user", editGuildMember)
+	s.handle("PUT", "guilds/:guild/members/:user/roles/:role", addGuildMemberRole)
+	s.handle("DELETE", "guilds/:guild/members/:user/roles/:role", removeGuildMemberRole)
+	s.handle("GET", "guilds/:guild/bans", getGuildBans)
+	s.handle("GET", "guilds/:guild/bans/:user", getGuildBan)
+	s.handle("PUT", "guilds/:guild/bans/:user", createGuildBan)
+	s.handle("DELETE", "guilds/:guild/bans/:user", deleteGuildBan)
+	s.handle("GET", "guilds/:guild/emojis", getGuildEmojis)
+	s.handle("POST", "guilds/:guild/emojis", createGuildEmoji)
+	s.handle("GET", "guilds/:guild/emojis/:emoji", getGuildEmoji)
+	s.handle("PATCH", "guilds/:guild/emojis/:emoji", editGuildEmoji)
+	s.handle("DELETE", "guilds/:guild/emojis/:emoji", deleteGuildEmoji)
+	s.handle("POST", "guilds/:guild/stickers", createGuildSticker)
+	s.handle("GET", "guilds/:guild/stickers/:sticker", getGuildSticker)
+	s.handle("PATCH", "guilds/:guild/stickers/:sticker", editGuildSticker)
+	s.handle("DELETE", "guilds/:guild/stickers/:sticker", deleteGuildSticker)
+	s.handle("POST", "guilds/:guild/scheduled-events", createGuildScheduledEvent)
+	s.handle("GET", "guilds/:guild/scheduled-events/:event", getGuildScheduledEvent)
+	s.handle("PATCH", "guilds/:guild/scheduled-events/:event", editGuildScheduledEvent)
+	s.handle("DELETE", "guilds/:guild/scheduled-events/:event", deleteGuildScheduledEvent)
+	s.handle("GET", "guilds/:guild/scheduled-events/:event/users", getGuildScheduledEventUsers)
+	s.handle("POST", "guilds/:guild/auto-moderation/rules", createGuildAutoModRule)
+	s.handle("GET", "guilds/:guild/auto-moderation/rules/:rule", getGuildAutoModRule)
+	s.handle("PATCH", "guilds/:guild/auto-moderation/rules/:rule", editGuildAutoModRule)
+	s.handle("DELETE", "guilds/:guild/auto-moderation/rules/:rule", deleteGuildAutoModRule)
+	s.handle("GET", "guilds/:guild/welcome-screen", getGuildWelcomeScreen)
+	s.handle("PATCH", "guilds/:guild/welcome-screen", editGuildWelcomeScreen)
+	s.handle("GET", "guilds/:guild/onboarding", getGuildOnboarding)
+	s.handle("PUT", "guilds/:guild/onboarding", editGuildOnboarding)
+	s.handle("GET", "guilds/:guild/templates", getGuildTemplates)
+	s.handle("POST", "guilds/:guild/templates", createGuildTemplate)
+	s.handle("PUT", "guilds/:guild/templates/:template", syncGuildTemplate)
+	s.handle("PATCH", "guilds/:guild/templates/:template", editGuildTemplate)
+	s.handle("DELETE", "guilds/:guild/templates/:template", deleteGuildTemplate)
+
+	s.handle("GET", "channels/:channel", getChannel)
+	s.handle("PATCH", "channels/:channel", editChannel)
+	s.handle("DELETE", "channels/:channel", deleteChannel)
+	s.handle("PUT", "channels/:channel/permissions/:overwrite", setChannelPermission)
+	s.handle("DELETE", "channels/:channel/permissions/:overwrite", deleteChannelPermission)
+	s.handle("GET", "channels/:channel/invites", getChannelInvites)
+	s.handle("POST", "channels/:channel/invites", createChannelInvite)
+	s.handle("POST", "channels/:channel/messages", createMessage)
+	s.handle("GET", "channels/:channel/messages/:message", getMessage)
+	s.handle("POST", "channels/:channel/messages/:message/threads", startMessageThread)
+	s.handle("POST", "channels/:channel/threads", startThread)
+	s.handle("PATCH", "channels/:channel/messages/:message", editMessage)
+	s.handle("DELETE", "channels/:channel/messages/:message", deleteMessage)
+	s.handle("PUT", "channels/:channel/pins/:message", pinMessage)
+	s.handle("DELETE", "channels/:channel/pins/:message", unpinMessage)
+	s.handle("GET", "channels/:channel/webhooks", getChannelWebhooks)
+	s.handle("POST", "channels/:channel/webhooks", createWebhook)
+
+	s.handle("GET", "invites/:invite", getInvite)
+	s.handle("DELETE", "invites/:invite", deleteInvite)
+
+	s.handle("GET", "webhooks/:webhook", getWebhook)
+	s.handle("PATCH", "webhooks/:webhook", editWebhook)
+	s.handle("DELETE", "webhooks/:webhook", deleteWebhook)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path == imagePath {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(testImage)
+		return
+	}
+	if segments, ok := apiPath(r.URL.Path); ok && strings.Join(segments, "/") == "oauth2/token" {
+		s.issueToken(w, r)
+		return
+	}
+	if !s.authorized(r.Header.Get("Authorization")) {
+		writeError(w, http.StatusUnauthorized, 0, "401: Unauthorized")
+		return
+	}
+	if s.rateLimit > 0 {
+		s.rateLimit--
+		s.writeRateLimited(w)
+		return
+	}
+
+	segments, ok := apiPath(r.URL.Path)
+	if !ok {
+		writeError(w, http.StatusNotFound, 0, "404: Not Found")
+		return
+	}
+	methodAllowed := false
+	for _, rt := range s.routes {
+		params, ok := match(rt.pattern, segments)
+		if !ok {
+			continue
+		}
+		if rt.method != r.Method {
+			methodAllowed = true
+			continue
+		}
+		w.Header().Set("X-RateLimit-Bucket", fmt.Sprintf("%x", strings.Join(rt.pattern, "/")))
+		w.Header().Set("X-RateLimit-Limit", "50")
+		w.Header().Set("X-RateLimit-Remaining", "49")
+		w.Header().Set("X-RateLimit-Reset-After", "1")
+		rt.handler(s, w, r, params)
+		return
+	}
+	if methodAllowed {
+		writeError(w, http.StatusMethodNotAllowed, 0, "405: Method Not Allowed")
+		return
+	}
+	writeError(w, http.StatusNotFound, 0, "404: Not Found")
+}
+
+func (s *Server) writeRateLimited(w http.ResponseWriter) {
+	retryAfter := s.RetryAfter.Seconds()
+	w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter+0.999)))
+	w.Header().Set("X-RateLimit-Limit", "50")
+	w.Header().Set("X-RateLimit-Remaining", "0")
+	w.Header().Set("X-RateLimit-Reset-After", strconv.FormatFloat(retryAfter, 'f', 3, 64))
+	w.Header().Set("X-RateLimit-Scope", "user")
+	writeJSON(w, http.StatusTooManyRequests, Object{
+		"message":     "You are being rate limited.",
+		"retry_after": retryAfter,
+		"global":      false,
+	})
+}
+
+// apiPath strips the /api/v{version}/ prefix and splits the rest of the path into segments.
+func apiPath(path string) ([]string, bool) {
+	path = strings.TrimPrefix(path, "/")
+	if !strings.HasPrefix(path, "api/v") {
+		return nil, false
+	}
+	_, rest, found := strings.Cut(path, "/")
+	if !found {
+		return nil, false
+	}
+	_, rest, found = strings.Cut(rest, "/")
+	if !found {
+		return nil, false
+	}
+
+	return strings.Split(strings.TrimSuffix(rest, "/"), "/"), true
+}
+
+func match(pattern []string, segments []string) ([]string, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	var params []string
+	for i, part := range pattern {
+		if strings.HasPrefix(part, ":") {
+			params = append(params, segments[i])
+			continue
+		}
+		if part != segments[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeNoContent(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeError(w http.ResponseWriter, status int, code int, message string) {
+	writeJSON(w, status, Object{
+		"code":    code,
+		"message": message,
+	})
+}
+
+func writeUnknown(w http.ResponseWriter, code int, message string) {
+	writeError(w, http.StatusNotFound, code, message)
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return false
+	}
+
+	return true
+}
+
+// merge copies the fields of a PATCH body onto the stored object. Image data URIs are replaced with a hash, the
+// same way Discord stores them.
+func merge(obj Object, body Object) {
+	for k, v := range body {
+		if str, ok := v.(string); ok && strings.HasPrefix(str, "data:") {
+			v = imageHash(str)
+		}
+		obj[k] = v
+	}
+}
+
+func imageHash(dataURI string) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(dataURI)))
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+func stringField(obj Object, key string) string {
+	v, _ := obj[key].(string)
+	return v
+}
+
+func objects(obj Object, key string) []Object {
+	var list []Object
+	items, _ := obj[key].([]any)
+	for _, item := range items {
+		if o, ok := item.(Object); ok {
+			list = append(list, o)
+		}
+	}
+
+	return list
+}
+
+func toAny(list []Object) []any {
+	items := make([]any, 0, len(list))
+	for _, o := range list {
+		items = append(items, o)
+	}
+
+	return items
+}
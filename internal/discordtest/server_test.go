@@ -0,0 +1,736 @@
+package discordtest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func newTestSession(t *testing.T, server *Server) *discordgo.Session {
+	t.Helper()
+	session, err := discordgo.New("Bot test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session.Client.Transport = &utils.BaseURLTransport{BaseURL: baseURL}
+
+	return session
+}
+
+func TestServerChannels(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	guildID := server.AddGuild("test")
+
+	category, err := session.GuildChannelCreateComplex(guildID, discordgo.GuildChannelCreateData{
+		Name: "category",
+		Type: discordgo.ChannelTypeGuildCategory,
+		PermissionOverwrites: []*discordgo.PermissionOverwrite{
+			{ID: guildID, Type: discordgo.PermissionOverwriteTypeRole, Deny: discordgo.PermissionViewChannel},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	channel, err := session.GuildChannelCreateComplex(guildID, discordgo.GuildChannelCreateData{
+		Name:     "text",
+		Type:     discordgo.ChannelTypeGuildText,
+		ParentID: category.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(channel.PermissionOverwrites) != 1 || channel.PermissionOverwrites[0].Deny != discordgo.PermissionViewChannel {
+		t.Errorf("expected the category overwrites to be copied, got %+v", channel.PermissionOverwrites)
+	}
+
+	topic := "updated"
+	if _, err := session.ChannelEditComplex(channel.ID, &discordgo.ChannelEdit{Topic: topic}); err != nil {
+		t.Fatal(err)
+	}
+	channel, err = session.Channel(channel.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if channel.Topic != topic || channel.Name != "text" {
+		t.Errorf("expected the edit to be merged, got topic %q name %q", channel.Topic, channel.Name)
+	}
+
+	if _, err := session.ChannelDelete(channel.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = session.Channel(channel.ID)
+	var restErr *discordgo.RESTError
+	if !errors.As(err, &restErr) {
+		t.Fatalf("expected a RESTError, got %v", err)
+	}
+	if restErr.Response.StatusCode != http.StatusNotFound || restErr.Message.Code != discordgo.ErrCodeUnknownChannel {
+		t.Errorf("expected 404 Unknown Channel, got %d %d", restErr.Response.StatusCode, restErr.Message.Code)
+	}
+}
+
+func TestServerRoles(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	guildID := server.AddGuild("test")
+
+	role, err := session.GuildRoleCreate(guildID, &discordgo.RoleParams{Name: "role"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if role.Position != 1 {
+		t.Errorf("expected new roles at position 1, got %d", role.Position)
+	}
+	roles, err := session.GuildRoles(guildID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) != 2 || roles[0].ID != guildID {
+		t.Errorf("expected @everyone and the new role, got %+v", roles)
+	}
+	if err := session.GuildRoleDelete(guildID, role.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := session.GuildRoleEdit(guildID, role.ID, &discordgo.RoleParams{Name: "renamed"}); err == nil {
+		t.Error("expected editing a deleted role to fail")
+	}
+}
+
+func TestServerMemberRoles(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	guildID := server.AddGuild("test")
+	userID := server.AddMember(guildID, "member")
+
+	role, err := session.GuildRoleCreate(guildID, &discordgo.RoleParams{Name: "role"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := session.GuildMemberRoleAdd(guildID, userID, role.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	member, err := session.GuildMember(guildID, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(member.Roles) != 1 || member.Roles[0] != role.ID {
+		t.Errorf("expected the role to be added once, got %v", member.Roles)
+	}
+	if err := session.GuildMemberRoleAdd(guildID, userID, "1"); err == nil {
+		t.Error("expected adding an unknown role to fail")
+	}
+	if err := session.GuildRoleDelete(guildID, role.ID); err != nil {
+		t.Fatal(err)
+	}
+	member, err = session.GuildMember(guildID, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(member.Roles) != 0 {
+		t.Errorf("expected deleting the role to remove it from members, got %v", member.Roles)
+	}
+}
+
+func TestServerBans(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	guildID := server.AddGuild("test")
+
+	var userIDs []string
+	for _, name := range []string{"first", "second", "third"} {
+		userID := server.AddMember(guildID, name)
+		if err := utils.BanCreateComplex(session, guildID, userID, &utils.BanCreate{DeleteMessageSeconds: 60}); err != nil {
+			t.Fatal(err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+	if _, err := session.GuildMember(guildID, userIDs[0]); err == nil {
+		t.Error("expected banned users to be removed from the server")
+	}
+	page, err := session.GuildBans(guildID, 2, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 2 || page[0].User.ID != userIDs[0] {
+		t.Errorf("expected the first two bans, got %+v", page)
+	}
+	page, err = session.GuildBans(guildID, 2, "", page[1].User.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 1 || page[0].User.ID != userIDs[2] {
+		t.Errorf("expected the last ban, got %+v", page)
+	}
+	if err := session.GuildBanDelete(guildID, userIDs[0]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := session.GuildBan(guildID, userIDs[0]); !utils.IsNotFound(err) {
+		t.Errorf("expected the ban to be lifted, got %v", err)
+	}
+	if err := session.GuildBanCreate(guildID, "1", 0); !utils.IsNotFound(err) {
+		t.Errorf("expected banning an unknown user to fail, got %v", err)
+	}
+}
+
+func TestServerEmojis(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	guildID := server.AddGuild("test")
+
+	emoji, err := session.GuildEmojiCreate(guildID, &discordgo.EmojiParams{
+		Name:  "terraform",
+		Image: utils.ImageDataURI("image/png", testImage),
+		Roles: []string{guildID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if emoji.Animated || len(emoji.Roles) != 1 {
+		t.Errorf("unexpected emoji %+v", emoji)
+	}
+	emoji, err = utils.EmojiEditComplex(session, guildID, emoji.ID, &utils.EmojiEdit{Name: "terraform_2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if emoji.Name != "terraform_2" || len(emoji.Roles) != 0 {
+		t.Errorf("expected the emoji to be renamed and available to everyone, got %+v", emoji)
+	}
+	if _, err := session.GuildEmojiCreate(guildID, &discordgo.EmojiParams{
+		Name:  "too_large",
+		Image: utils.ImageDataURI("image/png", make([]byte, maxEmojiSize+1)),
+	}); err == nil {
+		t.Error("expected an image over 256 KiB to be rejected")
+	}
+	if err := session.GuildEmojiDelete(guildID, emoji.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := session.GuildEmoji(guildID, emoji.ID); !utils.IsNotFound(err) {
+		t.Errorf("expected the emoji to be deleted, got %v", err)
+	}
+}
+
+func TestServerStickers(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	guildID := server.AddGuild("test")
+
+	sticker, err := utils.StickerCreateComplex(session, guildID, &utils.StickerCreate{
+		Name: "terraform",
+		Tags: "terraform",
+		File: &discordgo.File{Name: "terraform.png", ContentType: "image/png", Reader: bytes.NewReader(testImage)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sticker.FormatType != discordgo.StickerFormatTypePNG || sticker.Description != "" {
+		t.Errorf("unexpected sticker %+v", sticker)
+	}
+	sticker, err = utils.StickerEditComplex(session, guildID, sticker.ID, &utils.StickerEdit{Name: "terraform_2", Description: "The Terraform logo", Tags: "tf"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sticker.Name != "terraform_2" || sticker.Description != "The Terraform logo" || sticker.Tags != "tf" {
+		t.Errorf("expected the sticker to be edited, got %+v", sticker)
+	}
+	if _, err := utils.StickerCreateComplex(session, guildID, &utils.StickerCreate{
+		Name: "too_large",
+		Tags: "terraform",
+		File: &discordgo.File{Name: "too_large.png", ContentType: "image/png", Reader: bytes.NewReader(append(append([]byte{}, testImage...), make([]byte, maxStickerSize)...))},
+	}); err == nil {
+		t.Error("expected a file over 512 KiB to be rejected")
+	}
+	if err := utils.GuildStickerDelete(session, guildID, sticker.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := utils.GuildSticker(session, guildID, sticker.ID); !utils.IsNotFound(err) {
+		t.Errorf("expected the sticker to be deleted, got %v", err)
+	}
+}
+
+func TestServerScheduledEvents(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	guildID := server.AddGuild("test")
+	channelID := server.AddChannel(guildID, "voice", discordgo.ChannelTypeGuildVoice)
+	userID := server.AddMember(guildID, "subscriber")
+
+	start := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	params := &utils.ScheduledEventParams{
+		Name:               "weekly",
+		EntityType:         discordgo.GuildScheduledEventEntityTypeVoice,
+		ChannelID:          &channelID,
+		ScheduledStartTime: start,
+		PrivacyLevel:       discordgo.GuildScheduledEventPrivacyLevelGuildOnly,
+		RecurrenceRule: &utils.ScheduledEventRecurrenceRule{
+			Start:     start,
+			Frequency: utils.ScheduledEventFrequencyWeekly,
+			Interval:  1,
+			ByWeekday: []int{2},
+		},
+	}
+	event, err := utils.ScheduledEventCreateComplex(session, guildID, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Status != discordgo.GuildScheduledEventStatusScheduled || event.RecurrenceRule == nil {
+		t.Errorf("unexpected scheduled event %+v", event)
+	}
+
+	params.RecurrenceRule.ByWeekday = []int{1, 2}
+	if _, err := utils.ScheduledEventEditComplex(session, guildID, event.ID, params); err == nil {
+		t.Error("expected a weekly event on two days to be rejected")
+	}
+	params.RecurrenceRule = nil
+	params.Status = discordgo.GuildScheduledEventStatusCompleted
+	if _, err := utils.ScheduledEventEditComplex(session, guildID, event.ID, params); err == nil {
+		t.Error("expected a scheduled event not to be completed before it started")
+	}
+	params.Status = discordgo.GuildScheduledEventStatusActive
+	event, err = utils.ScheduledEventEditComplex(session, guildID, event.ID, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Status != discordgo.GuildScheduledEventStatusActive || event.RecurrenceRule != nil {
+		t.Errorf("expected the event to be started without a recurrence rule, got %+v", event)
+	}
+
+	server.SubscribeScheduledEvent(event.ID, userID)
+	users, err := utils.GetAllScheduledEventUsers(session, guildID, event.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users[0].User.ID != userID {
+		t.Errorf("expected the subscribed user, got %+v", users)
+	}
+	if err := session.GuildScheduledEventDelete(guildID, event.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := utils.GetScheduledEvent(session, guildID, event.ID); !utils.IsNotFound(err) {
+		t.Errorf("expected the scheduled event to be deleted, got %v", err)
+	}
+}
+
+func TestServerAutoModRules(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	guildID := server.AddGuild("test")
+	channelID := server.AddChannel(guildID, "alerts", discordgo.ChannelTypeGuildText)
+
+	params := &utils.AutoModerationRule{
+		Name:            "mentions",
+		EventType:       discordgo.AutoModerationEventMessageSend,
+		TriggerType:     utils.AutoModerationEventTriggerMentionSpam,
+		TriggerMetadata: &utils.AutoModerationTriggerMetadata{MentionTotalLimit: 5, MentionRaidProtectionEnabled: true},
+		Actions: []utils.AutoModerationAction{
+			{Type: discordgo.AutoModerationRuleActionBlockMessage, Metadata: &utils.AutoModerationActionMetadata{CustomMessage: "Too many mentions"}},
+			{Type: discordgo.AutoModerationRuleActionSendAlertMessage, Metadata: &utils.AutoModerationActionMetadata{ChannelID: channelID}},
+		},
+		Enabled: true,
+	}
+	rule, err := utils.AutoModerationRuleCreateComplex(session, guildID, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.TriggerMetadata == nil || rule.TriggerMetadata.MentionTotalLimit != 5 || !rule.TriggerMetadata.MentionRaidProtectionEnabled || rule.ExemptRoles == nil {
+		t.Errorf("unexpected rule %+v", rule)
+	}
+	if _, err := utils.AutoModerationRuleCreateComplex(session, guildID, params); err == nil {
+		t.Error("expected a second mention spam rule to be rejected")
+	}
+
+	params.Actions = []utils.AutoModerationAction{{Type: utils.AutoModerationRuleActionBlockMemberInteraction}}
+	if _, err := utils.AutoModerationRuleEditComplex(session, guildID, rule.ID, params); err == nil {
+		t.Error("expected block member interaction to be rejected for a mention spam rule")
+	}
+	params.Actions = []utils.AutoModerationAction{{Type: discordgo.AutoModerationRuleActionTimeout, Metadata: &utils.AutoModerationActionMetadata{Duration: 60}}}
+	params.Enabled = false
+	rule, err = utils.AutoModerationRuleEditComplex(session, guildID, rule.ID, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule.Enabled || len(rule.Actions) != 1 || rule.Actions[0].Metadata.Duration != 60 || rule.TriggerType != utils.AutoModerationEventTriggerMentionSpam {
+		t.Errorf("unexpected edited rule %+v", rule)
+	}
+
+	if err := session.AutoModerationRuleDelete(guildID, rule.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := utils.GetAutoModerationRule(session, guildID, rule.ID); !utils.IsNotFound(err) {
+		t.Errorf("expected the rule to be deleted, got %v", err)
+	}
+}
+
+func TestServerWelcomeScreen(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	guildID := server.AddGuild("test")
+	channelID := server.AddChannel(guildID, "rules", discordgo.ChannelTypeGuildText)
+	otherChannelID := server.AddChannel(server.AddGuild("other"), "rules", discordgo.ChannelTypeGuildText)
+
+	if _, err := utils.GetWelcomeScreen(session, guildID); !utils.IsNotFound(err) {
+		t.Errorf("expected no welcome screen without the COMMUNITY feature, got %v", err)
+	}
+	server.AddGuildFeature(guildID, "COMMUNITY")
+
+	wave := "👋"
+	description := "Welcome!"
+	edit := &utils.WelcomeScreenEdit{
+		Enabled:         true,
+		Description:     &description,
+		WelcomeChannels: []utils.WelcomeScreenChannel{{ChannelID: otherChannelID, Description: "Read the rules", EmojiName: &wave}},
+	}
+	if _, err := utils.WelcomeScreenEditComplex(session, guildID, edit); err == nil {
+		t.Error("expected a channel of another server to be rejected")
+	}
+	edit.WelcomeChannels[0].ChannelID = channelID
+	screen, err := utils.WelcomeScreenEditComplex(session, guildID, edit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if screen.Description != description || len(screen.WelcomeChannels) != 1 || *screen.WelcomeChannels[0].EmojiName != wave {
+		t.Errorf("unexpected welcome screen %+v", screen)
+	}
+	guild, err := session.Guild(guildID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enabled := false
+	for _, feature := range guild.Features {
+		enabled = enabled || feature == discordgo.GuildFeatureWelcomeScreenEnabled
+	}
+	if !enabled {
+		t.Errorf("expected the welcome screen to be enabled, got features %v", guild.Features)
+	}
+}
+
+func TestServerOnboarding(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	guildID := server.AddGuild("test")
+	server.AddGuildFeature(guildID, "COMMUNITY")
+	var channelIDs []string
+	for i := 0; i < 7; i++ {
+		channelIDs = append(channelIDs, server.AddChannel(guildID, fmt.Sprintf("channel-%d", i), discordgo.ChannelTypeGuildText))
+	}
+	role, err := session.GuildRoleCreate(guildID, &discordgo.RoleParams{Name: "pings"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enabled := true
+	mode := discordgo.GuildOnboardingModeAdvanced
+	prompts := []discordgo.GuildOnboardingPrompt{{
+		ID:    "1",
+		Title: "What are you here for?",
+		Options: []discordgo.GuildOnboardingPromptOption{
+			{ID: "2", Title: "Pings", RoleIDs: []string{role.ID}, EmojiName: "🔔"},
+			{ID: "2", Title: "Chatting", ChannelIDs: channelIDs[6:]},
+		},
+		InOnboarding: true,
+	}}
+	onboarding := &discordgo.GuildOnboarding{Prompts: &prompts, DefaultChannelIDs: channelIDs[:6], Enabled: &enabled}
+	if _, err := session.GuildOnboardingEdit(guildID, onboarding); err == nil {
+		t.Error("expected enabled onboarding with 6 default channels to be rejected")
+	}
+	onboarding.Mode = &mode
+	if _, err := session.GuildOnboardingEdit(guildID, onboarding); err == nil {
+		t.Error("expected options with the same ID to be rejected")
+	}
+	prompts[0].Options[1].ID = "3"
+	edited, err := session.GuildOnboardingEdit(guildID, onboarding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := (*edited.Prompts)[0].Options
+	if (*edited.Prompts)[0].ID != "1" || options[0].ID != "2" || options[1].ID != "3" || options[0].Emoji == nil || options[0].Emoji.Name != "🔔" {
+		t.Errorf("unexpected prompts %+v", *edited.Prompts)
+	}
+
+	disabled := false
+	if _, err := session.GuildOnboardingEdit(guildID, &discordgo.GuildOnboarding{Enabled: &disabled}); err != nil {
+		t.Fatal(err)
+	}
+	current, err := session.GuildOnboarding(guildID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *current.Enabled || len(*current.Prompts) != 1 || len(current.DefaultChannelIDs) != 6 {
+		t.Errorf("expected disabling onboarding to keep the prompts and default channels, got %+v", current)
+	}
+}
+
+func TestServerTemplates(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	guildID := server.AddGuild("test")
+	categoryID := server.AddChannel(guildID, "info", discordgo.ChannelTypeGuildCategory)
+	if _, err := session.ChannelEdit(server.AddChannel(guildID, "rules", discordgo.ChannelTypeGuildText), &discordgo.ChannelEdit{ParentID: categoryID}); err != nil {
+		t.Fatal(err)
+	}
+
+	template, err := utils.GuildTemplateCreateComplex(session, guildID, &utils.GuildTemplateParams{Name: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := utils.GuildTemplateCreateComplex(session, guildID, &utils.GuildTemplateParams{Name: "again"}); err == nil {
+		t.Error("expected a second template of the server to be rejected")
+	}
+	if template.IsDirty || template.SourceGuildID != guildID {
+		t.Errorf("unexpected template %+v", template)
+	}
+
+	server.AddChannel(guildID, "chat", discordgo.ChannelTypeGuildText)
+	templates, err := utils.GetGuildTemplates(session, guildID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(templates) != 1 || !templates[0].IsDirty {
+		t.Errorf("expected the template to be dirty after adding a channel, got %+v", templates)
+	}
+	if template, err = utils.SyncGuildTemplate(session, guildID, template.Code); err != nil || template.IsDirty {
+		t.Errorf("expected the synced template not to be dirty, got %+v, %v", template, err)
+	}
+
+	guild, err := session.GuildCreateWithTemplate(template.Code, "copy", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	channels, err := session.GuildChannels(guild.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parents := map[string]string{}
+	for _, channel := range channels {
+		parents[channel.Name] = channel.ParentID
+	}
+	if len(channels) != 4 || parents["rules"] == "" || parents["chat"] != "" {
+		t.Errorf("expected the channels of the template, got %v", parents)
+	}
+
+	if err := session.GuildTemplateDelete(guildID, template.Code); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := session.GuildCreateWithTemplate(template.Code, "copy", ""); !utils.IsNotFound(err) {
+		t.Errorf("expected a deleted template to be unknown, got %v", err)
+	}
+}
+
+func TestServerApplicationCommands(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	appID := server.Application["id"].(string)
+	guildID := server.AddGuild("test")
+
+	optional := utils.ApplicationCommandOption{Type: discordgo.ApplicationCommandOptionString, Name: "reason", Description: "Why"}
+	required := utils.ApplicationCommandOption{Type: discordgo.ApplicationCommandOptionUser, Name: "user", Description: "Who", Required: true}
+	command := &utils.ApplicationCommand{
+		Name:        "kick",
+		Description: "Kick a member",
+		Options:     []utils.ApplicationCommandOption{optional, required},
+	}
+	if _, err := utils.ApplicationCommandCreateComplex(session, appID, guildID, command); err == nil {
+		t.Error("expected a required option after an optional one to be rejected")
+	}
+	command.Options = []utils.ApplicationCommandOption{required, optional}
+	created, err := utils.ApplicationCommandCreateComplex(session, appID, guildID, command)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.GuildID != guildID || created.Type != discordgo.ChatApplicationCommand || len(created.Options) != 2 || created.Contexts != nil {
+		t.Errorf("unexpected command %+v", created)
+	}
+	again, err := utils.ApplicationCommandCreateComplex(session, appID, guildID, command)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.ID != created.ID {
+		t.Errorf("expected a command of the same name to be updated, got %s and %s", created.ID, again.ID)
+	}
+
+	global, err := utils.ApplicationCommandBulkOverwriteComplex(session, appID, "", []utils.ApplicationCommand{
+		{Type: discordgo.UserApplicationCommand, Name: "Report User"},
+		{Name: "ping", Description: "Ping the bot"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(global) != 2 || len(global[0].Contexts) != 3 {
+		t.Fatalf("unexpected commands %+v", global)
+	}
+	overwritten, err := utils.ApplicationCommandBulkOverwriteComplex(session, appID, "", []utils.ApplicationCommand{
+		{Name: "ping", Description: "Ping the bot again"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(overwritten) != 1 || overwritten[0].ID != global[1].ID {
+		t.Errorf("expected the ping command to keep its ID, got %+v", overwritten)
+	}
+	if _, err := utils.GetApplicationCommand(session, appID, "", global[0].ID); err == nil {
+		t.Error("expected the overwritten user command to be deleted")
+	}
+	if commands, err := utils.GetApplicationCommands(session, appID, guildID); err != nil || len(commands) != 1 {
+		t.Errorf("expected the server command to be unaffected, got %+v, %v", commands, err)
+	}
+
+	if err := session.ApplicationCommandDelete(appID, guildID, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := utils.GetApplicationCommand(session, appID, guildID, created.ID); err == nil {
+		t.Error("expected the deleted command to be gone")
+	}
+}
+
+func TestServerMessagesAndInvites(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	guildID := server.AddGuild("test")
+	channelID := server.AddChannel(guildID, "text", discordgo.ChannelTypeGuildText)
+
+	message, err := session.ChannelMessageSend(channelID, "hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := session.ChannelMessagePin(channelID, message.ID); err != nil {
+		t.Fatal(err)
+	}
+	message, err = session.ChannelMessage(channelID, message.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !message.Pinned || message.Content != "hello" {
+		t.Errorf("expected a pinned message, got %+v", message)
+	}
+
+	invite, err := session.ChannelInviteCreate(channelID, discordgo.Invite{MaxAge: 60, MaxUses: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invite.Code) != 8 || invite.MaxAge != 60 || invite.Guild.ID != guildID {
+		t.Errorf("unexpected invite %+v", invite)
+	}
+
+	server.DeleteChannel(channelID)
+	if _, err := session.InviteDelete(invite.Code); err == nil {
+		t.Error("expected invites to be removed with their channel")
+	}
+}
+
+func TestServerThreads(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+	guildID := server.AddGuild("test")
+	channelID := server.AddChannel(guildID, "text", discordgo.ChannelTypeGuildText)
+
+	message, err := session.ChannelMessageSend(channelID, "hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	thread, err := session.MessageThreadStartComplex(channelID, message.ID, &discordgo.ThreadStart{Name: "thread"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if thread.ID != message.ID || thread.Type != discordgo.ChannelTypeGuildPublicThread || thread.ParentID != channelID {
+		t.Errorf("unexpected thread %+v", thread)
+	}
+
+	archived := true
+	if _, err := session.ChannelEditComplex(thread.ID, &discordgo.ChannelEdit{Archived: &archived}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := session.ChannelEditComplex(thread.ID, &discordgo.ChannelEdit{Name: "renamed"}); err == nil {
+		t.Error("expected archived threads to reject edits")
+	}
+	archived = false
+	thread, err = session.ChannelEditComplex(thread.ID, &discordgo.ChannelEdit{Archived: &archived})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if thread.ThreadMetadata == nil || thread.ThreadMetadata.Archived {
+		t.Errorf("expected the thread to be unarchived, got %+v", thread.ThreadMetadata)
+	}
+
+	server.DeleteChannel(channelID)
+	if _, err := session.Channel(thread.ID); err == nil {
+		t.Error("expected threads to be removed with their channel")
+	}
+}
+
+func TestServerSnowflakes(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	guildID := server.AddGuild("test")
+
+	created, err := discordgo.SnowflakeTimestamp(guildID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(created) > time.Minute || time.Since(created) < -time.Minute {
+		t.Errorf("expected the snowflake to be created now, got %s", created)
+	}
+	if server.AddGuild("test") == guildID {
+		t.Error("expected unique snowflakes")
+	}
+}
+
+func TestServerUnauthorized(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api/v9/users/@me")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", resp.StatusCode)
+	}
+}
+
+func TestServerRateLimit(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	session := newTestSession(t, server)
+
+	server.RateLimitNext(1)
+	session.ShouldRetryOnRateLimit = false
+	if _, err := session.User("@me"); err == nil {
+		t.Fatal("expected a rate limited request to fail")
+	}
+
+	server.RateLimitNext(1)
+	session.ShouldRetryOnRateLimit = true
+	user, err := session.User("@me")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != server.BotUser["id"] {
+		t.Errorf("expected the bot user, got %+v", user)
+	}
+}
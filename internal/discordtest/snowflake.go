@@ -0,0 +1,23 @@
+package discordtest
+
+import (
+	"strconv"
+	"time"
+)
+
+// discordEpoch is the first millisecond of 2015 in Unix milliseconds.
+const discordEpoch = 1420070400000
+
+// snowflakeGenerator hands out IDs in the same format as Discord so that callers can read the creation time back
+// out of them.
+type snowflakeGenerator struct {
+	increment int64
+}
+
+func (g *snowflakeGenerator) Next() string {
+	g.increment++
+	ms := time.Now().UnixMilli() - discordEpoch
+	id := ms<<22 | 1<<17 | 1<<12 | g.increment&0xFFF
+
+	return strconv.FormatInt(id, 10)
+}
@@ -0,0 +1,151 @@
+package discordtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+	"io"
+	"net/http"
+)
+
+// maxStickerSize is the largest sticker file Discord accepts.
+const maxStickerSize = 512 * 1024
+
+func (s *Server) sticker(w http.ResponseWriter, guildID string, stickerID string) (Object, bool) {
+	guild, ok := s.guild(w, guildID)
+	if !ok {
+		return nil, false
+	}
+	sticker := findByID(objects(guild, "stickers"), stickerID)
+	if sticker == nil {
+		writeUnknown(w, discordgo.ErrCodeUnknownSticker, "Unknown Sticker")
+		return nil, false
+	}
+
+	return sticker, true
+}
+
+func getGuildSticker(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	sticker, ok := s.sticker(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, sticker)
+}
+
+// createGuildSticker reads the multipart form that stickers are uploaded with.
+func createGuildSticker(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	guild, ok := s.guild(w, params[0])
+	if !ok {
+		return
+	}
+	if err := r.ParseMultipartForm(2 * maxStickerSize); err != nil {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	body := Object{
+		"name":        r.FormValue("name"),
+		"description": r.FormValue("description"),
+		"tags":        r.FormValue("tags"),
+	}
+	if !validSticker(body) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	if len(content) > maxStickerSize {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeFileUploadedExceedsTheMaximumSize, "File cannot be larger than 512.0 kb.")
+		return
+	}
+	format := stickerFormat(content)
+	if format == 0 {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFileUploaded, "Invalid file uploaded")
+		return
+	}
+
+	sticker := Object{
+		"id":          s.snowflakes.Next(),
+		"guild_id":    params[0],
+		"type":        discordgo.StickerTypeGuild,
+		"format_type": format,
+		"available":   true,
+		"user":        s.BotUser,
+	}
+	merge(sticker, body)
+	guild["stickers"] = append(guild["stickers"].([]any), sticker)
+	writeJSON(w, http.StatusCreated, sticker)
+}
+
+func editGuildSticker(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	sticker, ok := s.sticker(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	edited := Object{}
+	merge(edited, sticker)
+	for _, key := range []string{"name", "description", "tags"} {
+		if v, ok := body[key]; ok {
+			edited[key] = v
+		}
+	}
+	if !validSticker(edited) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	merge(sticker, edited)
+	writeJSON(w, http.StatusOK, sticker)
+}
+
+func deleteGuildSticker(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.sticker(w, params[0], params[1]); !ok {
+		return
+	}
+	guild := s.guilds[params[0]]
+	stickers := objects(guild, "stickers")
+	for i, sticker := range stickers {
+		if stringField(sticker, "id") == params[1] {
+			guild["stickers"] = toAny(append(stickers[:i], stickers[i+1:]...))
+			break
+		}
+	}
+	writeNoContent(w)
+}
+
+// validSticker checks the lengths Discord allows for the name, description and tags of a sticker.
+func validSticker(sticker Object) bool {
+	name := len(stringField(sticker, "name"))
+	description := len(stringField(sticker, "description"))
+	tags := len(stringField(sticker, "tags"))
+
+	return name >= 2 && name <= 30 && (description == 0 || description >= 2 && description <= 100) && tags >= 1 && tags <= 200
+}
+
+// stickerFormat returns the discordgo.StickerFormat of a sticker file, or 0 when Discord does not accept it.
+func stickerFormat(content []byte) discordgo.StickerFormat {
+	switch {
+	case bytes.HasPrefix(content, []byte("\x89PNG")) && bytes.Contains(content, []byte("acTL")):
+		return discordgo.StickerFormatTypeAPNG
+	case bytes.HasPrefix(content, []byte("\x89PNG")):
+		return discordgo.StickerFormatTypePNG
+	case bytes.HasPrefix(content, []byte("GIF8")):
+		return discordgo.StickerFormatTypeGIF
+	case json.Valid(content):
+		return discordgo.StickerFormatTypeLottie
+	}
+
+	return 0
+}
@@ -0,0 +1,238 @@
+package discordtest
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// guildSnapshot returns the server the way a template stores it. Roles and channels get integer placeholder IDs,
+// starting with @everyone, and channels refer to their category by its placeholder.
+func (s *Server) guildSnapshot(guild Object) Object {
+	guildID := stringField(guild, "id")
+	placeholders := map[string]int{}
+	roles := []any{}
+	for _, role := range objects(guild, "roles") {
+		placeholders[stringField(role, "id")] = len(placeholders)
+		roles = append(roles, Object{
+			"id":          placeholders[stringField(role, "id")],
+			"name":        role["name"],
+			"color":       role["color"],
+			"hoist":       role["hoist"],
+			"mentionable": role["mentionable"],
+			"permissions": role["permissions"],
+		})
+	}
+
+	var guildChannels []Object
+	for _, channel := range s.channels {
+		if stringField(channel, "guild_id") == guildID {
+			guildChannels = append(guildChannels, channel)
+		}
+	}
+	sort.Slice(guildChannels, func(i, j int) bool {
+		return number(guildChannels[i]["position"]) < number(guildChannels[j]["position"])
+	})
+	for _, channel := range guildChannels {
+		placeholders[stringField(channel, "id")] = len(placeholders)
+	}
+	channels := []any{}
+	for _, channel := range guildChannels {
+		var parentID any
+		if parent, ok := placeholders[stringField(channel, "parent_id")]; ok {
+			parentID = parent
+		}
+		channels = append(channels, Object{
+			"id":                  placeholders[stringField(channel, "id")],
+			"parent_id":           parentID,
+			"type":                channel["type"],
+			"name":                channel["name"],
+			"topic":               channel["topic"],
+			"nsfw":                channel["nsfw"],
+			"bitrate":             channel["bitrate"],
+			"user_limit":          channel["user_limit"],
+			"rate_limit_per_user": channel["rate_limit_per_user"],
+		})
+	}
+
+	snapshot := Object{
+		"name":                          guild["name"],
+		"verification_level":            guild["verification_level"],
+		"default_message_notifications": guild["default_message_notifications"],
+		"explicit_content_filter":       guild["explicit_content_filter"],
+		"afk_timeout":                   guild["afk_timeout"],
+		"roles":                         roles,
+		"channels":                      channels,
+	}
+	// A JSON round trip gives the snapshot the same number types as a stored one, for comparing them.
+	var copied Object
+	data, _ := json.Marshal(snapshot)
+	_ = json.Unmarshal(data, &copied)
+
+	return copied
+}
+
+// guildTemplate returns the template of a server with whether the server changed since it was synced.
+func (s *Server) guildTemplate(w http.ResponseWriter, guildID string, code string) (Object, bool) {
+	guild, ok := s.guild(w, guildID)
+	if !ok {
+		return nil, false
+	}
+	template, ok := s.templates[code]
+	if !ok || stringField(template, "source_guild_id") != guildID {
+		writeUnknown(w, discordgo.ErrCodeUnknownGuildTemplate, "Unknown Guild Template")
+		return nil, false
+	}
+	current, _ := json.Marshal(s.guildSnapshot(guild))
+	synced, _ := json.Marshal(template["serialized_source_guild"])
+	template["is_dirty"] = string(current) != string(synced)
+
+	return template, true
+}
+
+func validTemplate(body Object) bool {
+	name := len(stringField(body, "name"))
+
+	return name >= 1 && name <= 100 && len(stringField(body, "description")) <= 120
+}
+
+func getGuildTemplates(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.guild(w, params[0]); !ok {
+		return
+	}
+	templates := []any{}
+	for code, template := range s.templates {
+		if stringField(template, "source_guild_id") == params[0] {
+			template, _ = s.guildTemplate(w, params[0], code)
+			templates = append(templates, template)
+		}
+	}
+	writeJSON(w, http.StatusOK, templates)
+}
+
+func createGuildTemplate(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	guild, ok := s.guild(w, params[0])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if !validTemplate(body) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	for _, template := range s.templates {
+		if stringField(template, "source_guild_id") == params[0] {
+			writeError(w, http.StatusBadRequest, discordgo.ErrCodeGuildAlreadyHasATemplate, "A server can only have a single template.")
+			return
+		}
+	}
+	code := "tpl" + s.snowflakes.Next()
+	template := Object{
+		"code":                    code,
+		"name":                    body["name"],
+		"description":             body["description"],
+		"usage_count":             0,
+		"creator_id":              s.BotUser["id"],
+		"creator":                 s.BotUser,
+		"created_at":              now(),
+		"updated_at":              now(),
+		"source_guild_id":         params[0],
+		"serialized_source_guild": s.guildSnapshot(guild),
+		"is_dirty":                false,
+	}
+	s.templates[code] = template
+	writeJSON(w, http.StatusOK, template)
+}
+
+func syncGuildTemplate(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	template, ok := s.guildTemplate(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	template["serialized_source_guild"] = s.guildSnapshot(s.guilds[params[0]])
+	template["updated_at"] = now()
+	template["is_dirty"] = false
+	writeJSON(w, http.StatusOK, template)
+}
+
+func editGuildTemplate(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	template, ok := s.guildTemplate(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	edited := Object{"name": template["name"], "description": template["description"]}
+	merge(edited, body)
+	if !validTemplate(edited) {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	merge(template, edited)
+	writeJSON(w, http.StatusOK, template)
+}
+
+func deleteGuildTemplate(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	template, ok := s.guildTemplate(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	delete(s.templates, params[1])
+	writeJSON(w, http.StatusOK, template)
+}
+
+func getTemplate(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	template, ok := s.templates[params[0]]
+	if !ok {
+		writeUnknown(w, discordgo.ErrCodeUnknownGuildTemplate, "Unknown Guild Template")
+		return
+	}
+	if template, ok = s.guildTemplate(w, stringField(template, "source_guild_id"), params[0]); !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, template)
+}
+
+// createGuildFromTemplate creates a server with the roles, channels and settings of a template.
+func createGuildFromTemplate(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	template, ok := s.templates[params[0]]
+	if !ok {
+		writeUnknown(w, discordgo.ErrCodeUnknownGuildTemplate, "Unknown Guild Template")
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if stringField(body, "name") == "" {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+
+	// newGuild takes string placeholders, so the snapshot is copied with its integer IDs as strings.
+	var guild Object
+	data, _ := json.Marshal(template["serialized_source_guild"])
+	_ = json.Unmarshal(data, &guild)
+	for _, key := range []string{"roles", "channels"} {
+		for _, item := range objects(guild, key) {
+			item["id"] = strconv.Itoa(int(number(item["id"])))
+			if parentID, ok := item["parent_id"].(float64); ok {
+				item["parent_id"] = strconv.Itoa(int(parentID))
+			}
+		}
+	}
+	guild["name"] = body["name"]
+	if icon := stringField(body, "icon"); icon != "" {
+		guild["icon"] = icon
+	}
+	created := s.newGuild(guild)
+	template["usage_count"] = int(number(template["usage_count"])) + 1
+	writeJSON(w, http.StatusCreated, created)
+}
@@ -0,0 +1,149 @@
+package discordtest
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+)
+
+// errCodeThreadArchived is returned for edits to archived threads. discordgo does not define it.
+const errCodeThreadArchived = 50083
+
+// threadMetadataFields are the channel edit fields Discord stores in thread_metadata.
+var threadMetadataFields = []string{"archived", "auto_archive_duration", "locked", "invitable"}
+
+func isThread(channel Object) bool {
+	switch discordgo.ChannelType(number(channel["type"])) {
+	case discordgo.ChannelTypeGuildNewsThread, discordgo.ChannelTypeGuildPublicThread, discordgo.ChannelTypeGuildPrivateThread:
+		return true
+	}
+
+	return false
+}
+
+func (s *Server) newThread(parent Object, id string, body Object) Object {
+	metadata := Object{
+		"archived":              false,
+		"auto_archive_duration": 4320,
+		"archive_timestamp":     now(),
+		"locked":                false,
+	}
+	for _, key := range threadMetadataFields {
+		if v, ok := body[key]; ok {
+			metadata[key] = v
+			delete(body, key)
+		}
+	}
+	if number(body["type"]) == float64(discordgo.ChannelTypeGuildPrivateThread) {
+		if _, ok := metadata["invitable"]; !ok {
+			metadata["invitable"] = true
+		}
+	}
+	body["parent_id"] = parent["id"]
+	body["thread_metadata"] = metadata
+
+	thread := s.newChannel(stringField(parent, "guild_id"), body)
+	if id != "" {
+		delete(s.channels, stringField(thread, "id"))
+		thread["id"] = id
+		s.channels[id] = thread
+	}
+
+	return thread
+}
+
+// editThread applies a channel edit to a thread. Like Discord, it only allows archived threads to be unarchived or
+// locked.
+func editThread(w http.ResponseWriter, thread Object, body Object) bool {
+	metadata, _ := thread["thread_metadata"].(Object)
+	if archived, _ := metadata["archived"].(bool); archived {
+		for key, value := range body {
+			if key == "locked" {
+				continue
+			}
+			if key == "archived" && value == false {
+				continue
+			}
+			writeError(w, http.StatusBadRequest, errCodeThreadArchived, "Thread is archived")
+			return false
+		}
+	}
+	for _, key := range threadMetadataFields {
+		if v, ok := body[key]; ok {
+			metadata[key] = v
+			delete(body, key)
+		}
+	}
+
+	return true
+}
+
+func startThread(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	parent, ok := s.channel(w, params[0])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if stringField(body, "name") == "" {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+
+	// Posts in forum and media channels are public threads that start with a message sharing their ID.
+	message, _ := body["message"].(Object)
+	delete(body, "message")
+	switch discordgo.ChannelType(number(parent["type"])) {
+	case discordgo.ChannelTypeGuildForum, discordgo.ChannelTypeGuildMedia:
+		if stringField(message, "content") == "" && len(objects(message, "embeds")) == 0 {
+			writeError(w, http.StatusBadRequest, discordgo.ErrCodeCannotSendEmptyMessage, "Cannot send an empty message")
+			return
+		}
+		body["type"] = int(discordgo.ChannelTypeGuildPublicThread)
+		if _, ok := body["applied_tags"]; !ok {
+			body["applied_tags"] = []any{}
+		}
+	default:
+		message = nil
+		if _, ok := body["type"]; !ok {
+			body["type"] = int(discordgo.ChannelTypeGuildPrivateThread)
+		}
+	}
+
+	thread := s.newThread(parent, "", body)
+	if message != nil {
+		s.newMessage(thread, stringField(thread, "id"), message)
+	}
+	writeJSON(w, http.StatusCreated, thread)
+}
+
+func startMessageThread(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	parent, ok := s.channel(w, params[0])
+	if !ok {
+		return
+	}
+	message, ok := s.message(w, params[0], params[1])
+	if !ok {
+		return
+	}
+	if _, ok := s.channels[params[1]]; ok {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeThreadAlreadyCreatedForThisMessage, "A thread has already been created for this message")
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if stringField(body, "name") == "" {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+	body["type"] = int(discordgo.ChannelTypeGuildPublicThread)
+	if discordgo.ChannelType(number(parent["type"])) == discordgo.ChannelTypeGuildNews {
+		body["type"] = int(discordgo.ChannelTypeGuildNewsThread)
+	}
+	thread := s.newThread(parent, stringField(message, "id"), body)
+	message["thread"] = thread
+	writeJSON(w, http.StatusCreated, thread)
+}
@@ -0,0 +1,95 @@
+package discordtest
+
+import (
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+)
+
+func (s *Server) webhook(w http.ResponseWriter, webhookID string) (Object, bool) {
+	webhook, ok := s.webhooks[webhookID]
+	if !ok {
+		writeUnknown(w, discordgo.ErrCodeUnknownWebhook, "Unknown Webhook")
+	}
+
+	return webhook, ok
+}
+
+func getChannelWebhooks(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.channel(w, params[0]); !ok {
+		return
+	}
+	webhooks := []Object{}
+	for _, webhook := range s.webhooks {
+		if stringField(webhook, "channel_id") == params[0] {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	writeJSON(w, http.StatusOK, webhooks)
+}
+
+func createWebhook(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	channel, ok := s.channel(w, params[0])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if stringField(body, "name") == "" {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+
+	id := s.snowflakes.Next()
+	webhook := Object{
+		"id":         id,
+		"type":       int(discordgo.WebhookTypeIncoming),
+		"guild_id":   channel["guild_id"],
+		"channel_id": channel["id"],
+		"user":       s.BotUser,
+		"name":       "",
+		"avatar":     nil,
+		"token":      fmt.Sprintf("%x", id),
+	}
+	delete(body, "id")
+	merge(webhook, body)
+	s.webhooks[id] = webhook
+	writeJSON(w, http.StatusOK, webhook)
+}
+
+func getWebhook(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	webhook, ok := s.webhook(w, params[0])
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, webhook)
+}
+
+func editWebhook(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	webhook, ok := s.webhook(w, params[0])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if channelID := stringField(body, "channel_id"); channelID != "" {
+		if _, ok := s.channel(w, channelID); !ok {
+			return
+		}
+	}
+	delete(body, "id")
+	merge(webhook, body)
+	writeJSON(w, http.StatusOK, webhook)
+}
+
+func deleteWebhook(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.webhook(w, params[0]); !ok {
+		return
+	}
+	delete(s.webhooks, params[0])
+	writeNoContent(w)
+}
@@ -0,0 +1,76 @@
+package discordtest
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+)
+
+// maxWelcomeChannels is how many channels a welcome screen can show.
+const maxWelcomeChannels = 5
+
+// communityGuild returns a server that has the COMMUNITY feature, which a welcome screen needs.
+func (s *Server) communityGuild(w http.ResponseWriter, guildID string) (Object, bool) {
+	guild, ok := s.guild(w, guildID)
+	if !ok {
+		return nil, false
+	}
+	if !hasFeature(guild, "COMMUNITY") {
+		writeUnknown(w, discordgo.ErrCodeUnknownGuildWelcomeScreen, "Unknown Guild Welcome Screen")
+		return nil, false
+	}
+
+	return guild, true
+}
+
+func (s *Server) welcomeScreen(guildID string) Object {
+	screen, ok := s.welcomeScreens[guildID]
+	if !ok {
+		screen = Object{"description": nil, "welcome_channels": []any{}}
+		s.welcomeScreens[guildID] = screen
+	}
+
+	return screen
+}
+
+func getGuildWelcomeScreen(s *Server, w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.communityGuild(w, params[0]); !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.welcomeScreen(params[0]))
+}
+
+func editGuildWelcomeScreen(s *Server, w http.ResponseWriter, r *http.Request, params []string) {
+	guild, ok := s.communityGuild(w, params[0])
+	if !ok {
+		return
+	}
+	var body Object
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	channels := objects(body, "welcome_channels")
+	valid := len(channels) <= maxWelcomeChannels && len(stringField(body, "description")) <= 140
+	for _, welcome := range channels {
+		channel, ok := s.channels[stringField(welcome, "channel_id")]
+		description := len(stringField(welcome, "description"))
+		valid = valid && ok && stringField(channel, "guild_id") == params[0] && description >= 1 && description <= 42
+	}
+	if !valid {
+		writeError(w, http.StatusBadRequest, discordgo.ErrCodeInvalidFormBody, "Invalid Form Body")
+		return
+	}
+
+	screen := s.welcomeScreen(params[0])
+	for _, key := range []string{"description", "welcome_channels"} {
+		if value, ok := body[key]; ok {
+			screen[key] = value
+		}
+	}
+	if screen["welcome_channels"] == nil {
+		screen["welcome_channels"] = []any{}
+	}
+	if enabled, ok := body["enabled"].(bool); ok {
+		setFeature(guild, string(discordgo.GuildFeatureWelcomeScreenEnabled), enabled)
+	}
+	writeJSON(w, http.StatusOK, screen)
+}
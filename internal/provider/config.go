@@ -1,26 +1,285 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
 	"github.com/bwmarrin/discordgo"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
 )
 
+const (
+	// defaultRequestTimeout matches the timeout discordgo.New gives its client.
+	defaultRequestTimeout = 20 * time.Second
+	defaultMaxRetries     = 5
+	defaultMaxRetryWait   = 60 * time.Second
+)
+
+// Application flags that grant privileged gateway intents. The limited flags are given to applications in less than
+// 100 servers and enable the intent all the same.
+const (
+	applicationFlagGatewayPresence              = 1 << 12
+	applicationFlagGatewayPresenceLimited       = 1 << 13
+	applicationFlagGatewayGuildMembers          = 1 << 14
+	applicationFlagGatewayGuildMembersLimited   = 1 << 15
+	applicationFlagGatewayMessageContent        = 1 << 18
+	applicationFlagGatewayMessageContentLimited = 1 << 19
+)
+
+// defaultOAuth2Scopes are requested with the client credentials grant when no scopes are configured.
+var defaultOAuth2Scopes = []string{"identify", "applications.commands.update"}
+
 type Config struct {
-	Token    string
-	ClientID string
-	Secret   string
+	// Token is the bot token. It can be empty when ClientID and Secret are set, every request then uses a Bearer token.
+	Token string
+	// ClientID and Secret get Bearer tokens with the OAuth2 client credentials grant. With a bot token they are only
+	// used for the endpoints that require a Bearer token.
+	ClientID     string
+	Secret       string
+	OAuth2Scopes []string
+	// BaseURL replaces https://discord.com for API requests when set.
+	BaseURL string
+	// ProxyURL is used for every request when set, otherwise the standard proxy environment variables are used.
+	ProxyURL string
+	// CABundle and CABundleFile are PEM encoded certificates trusted in addition to the system pool.
+	CABundle       string
+	CABundleFile   string
+	RequestTimeout time.Duration
+	// MaxRetries, MaxRetryWait and MaxConcurrentRequests configure utils.RetryTransport.
+	MaxRetries            int
+	MaxRetryWait          time.Duration
+	MaxConcurrentRequests int
+	// AuditLogReason is sent with every mutating request that does not set its own reason.
+	AuditLogReason string
+	// DefaultServerID is used by resources and data sources that do not set server_id.
+	DefaultServerID string
 }
 
 type Context struct {
 	Session *discordgo.Session
-	Config  *Config
+	// DownloadClient fetches files from URLs given in the configuration. It uses the proxy, CA bundle and timeout of
+	// the provider, but never sends the Discord credentials or audit log reason.
+	DownloadClient *http.Client
+	Config         *Config
+	// BotUserID, ApplicationID and PrivilegedIntents are set by Identify. BotUserID is empty without a bot token.
+	BotUserID         string
+	ApplicationID     string
+	PrivilegedIntents discordgo.Intent
 }
 
-func (c *Config) Client(version string) (*Context, error) {
+// Client creates the session used by resources and data sources. ctx is only used for logging retries.
+func (c *Config) Client(ctx context.Context, version string) (*Context, error) {
 	session, err := discordgo.New(c.Token)
 	if err != nil {
 		return nil, err
 	}
 	session.UserAgent = "discord-terraform/" + version
+	// Retries are handled by utils.RetryTransport. discordgo would otherwise retry rate limits forever on its own.
+	session.ShouldRetryOnRateLimit = false
+	session.MaxRestRetries = 0
+
+	transport, err := c.transport()
+	if err != nil {
+		return nil, err
+	}
+	session.Client, err = c.httpClient(ctx, transport)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Context{Config: c, Session: session, DownloadClient: &http.Client{Transport: transport, Timeout: c.requestTimeout()}}, nil
+}
+
+// tokenFromFile reads a token from a file, ignoring surrounding whitespace such as a trailing newline.
+func tokenFromFile(name string) (string, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("%s is empty", name)
+	}
+
+	return token, nil
+}
+
+// tokenFromCommand runs a credential helper and returns what it prints. Its output is never included in errors, only
+// what it wrote to stderr.
+func tokenFromCommand(ctx context.Context, command []string, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return "", fmt.Errorf("%s did not finish within %s", command[0], timeout)
+		}
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("%s: %w: %s", command[0], err, message)
+		}
+		return "", fmt.Errorf("%s: %w", command[0], err)
+	}
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("%s did not print a token", command[0])
+	}
+
+	return token, nil
+}
+
+// Identify checks the credentials with Discord and records who they belong to. With a bot token the current user and
+// application are used, a Bearer token only can describe its own authorization.
+func (c *Context) Identify(ctx context.Context) error {
+	var application *discordgo.Application
+	if c.Config.Token != "" {
+		user, err := c.Session.User("@me", discordgo.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		c.BotUserID = user.ID
+
+		// Session.Application takes no request options, so it cannot be cancelled with ctx.
+		endpoint := discordgo.EndpointOAuth2Application("@me")
+		body, err := c.Session.RequestWithBucketID("GET", endpoint, nil, discordgo.EndpointOAuth2Application(""), discordgo.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(body, &application); err != nil {
+			return err
+		}
+	} else {
+		endpoint := discordgo.EndpointOAuth2 + "@me"
+		body, err := c.Session.RequestWithBucketID("GET", endpoint, nil, endpoint, discordgo.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		var authorization struct {
+			Application *discordgo.Application `json:"application"`
+		}
+		if err := json.Unmarshal(body, &authorization); err != nil {
+			return err
+		}
+		if authorization.Application == nil {
+			return errors.New("the OAuth2 authorization has no application")
+		}
+		application = authorization.Application
+	}
+
+	c.ApplicationID = application.ID
+	c.PrivilegedIntents = privilegedIntents(application.Flags)
+
+	return nil
+}
+
+func privilegedIntents(flags int) discordgo.Intent {
+	var intents discordgo.Intent
+	if flags&(applicationFlagGatewayPresence|applicationFlagGatewayPresenceLimited) != 0 {
+		intents |= discordgo.IntentGuildPresences
+	}
+	if flags&(applicationFlagGatewayGuildMembers|applicationFlagGatewayGuildMembersLimited) != 0 {
+		intents |= discordgo.IntentGuildMembers
+	}
+	if flags&(applicationFlagGatewayMessageContent|applicationFlagGatewayMessageContentLimited) != 0 {
+		intents |= discordgo.IntentMessageContent
+	}
+
+	return intents
+}
+
+// transport builds the transport with the proxy and CA bundle settings, which every request of the provider goes
+// through.
+func (c *Config) transport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if c.ProxyURL != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if c.CABundle != "" || c.CABundleFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if c.CABundle != "" && !pool.AppendCertsFromPEM([]byte(c.CABundle)) {
+			return nil, errors.New("no certificates found in the CA bundle")
+		}
+		if c.CABundleFile != "" {
+			bundle, err := os.ReadFile(c.CABundleFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the CA bundle file: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(bundle) {
+				return nil, fmt.Errorf("no certificates found in %s", c.CABundleFile)
+			}
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return transport, nil
+}
+
+// requestTimeout is how long a single request may take.
+func (c *Config) requestTimeout() time.Duration {
+	if c.RequestTimeout == 0 {
+		return defaultRequestTimeout
+	}
+
+	return c.RequestTimeout
+}
+
+// httpClient builds the client used by the session on top of transport. The transport is set per session so nothing
+// changes for other users of discordgo in the same process.
+func (c *Config) httpClient(ctx context.Context, transport *http.Transport) (*http.Client, error) {
+	var roundTripper http.RoundTripper = transport
+	if c.BaseURL != "" {
+		baseURL, err := url.Parse(c.BaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid API URL: %w", err)
+		}
+		if baseURL.Scheme != "http" && baseURL.Scheme != "https" || baseURL.Host == "" {
+			return nil, fmt.Errorf("invalid API URL %q: must be an absolute http or https URL", c.BaseURL)
+		}
+		roundTripper = &utils.BaseURLTransport{BaseURL: baseURL, Next: transport}
+	}
+
+	timeout := c.requestTimeout()
+
+	if c.ClientID != "" && c.Secret != "" {
+		scopes := c.OAuth2Scopes
+		if len(scopes) == 0 {
+			scopes = defaultOAuth2Scopes
+		}
+		// Token requests get their own retries, the request waiting on the token already holds a concurrency slot.
+		tokenClient := &http.Client{Transport: utils.NewRetryTransport(ctx, roundTripper, c.MaxRetries, c.MaxRetryWait, 0, timeout)}
+		roundTripper = &utils.AuthTransport{
+			Bearer: &utils.OAuth2TokenSource{ClientID: c.ClientID, Secret: c.Secret, Scopes: scopes, Client: tokenClient},
+			Next:   roundTripper,
+		}
+	}
+
+	if c.AuditLogReason != "" {
+		roundTripper = &utils.AuditLogTransport{Reason: c.AuditLogReason, Next: roundTripper}
+	}
+
+	roundTripper = utils.NewRetryTransport(ctx, roundTripper, c.MaxRetries, c.MaxRetryWait, c.MaxConcurrentRequests, timeout)
 
-	return &Context{Config: c, Session: session}, nil
+	return &http.Client{Transport: roundTripper}, nil
 }
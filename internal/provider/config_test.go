@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/discordtest"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfigClientProxy(t *testing.T) {
+	server := discordtest.NewServer()
+	defer server.Close()
+
+	proxied := 0
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied++
+		r.RequestURI = ""
+		resp, err := http.DefaultTransport.RoundTrip(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+		for key, values := range resp.Header {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(resp.StatusCode)
+		_, _ = io.Copy(w, resp.Body)
+	}))
+	defer proxy.Close()
+
+	config := Config{Token: "Bot test", BaseURL: server.URL, ProxyURL: proxy.URL, RequestTimeout: 5 * time.Second}
+	client, err := config.Client(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if timeout := client.Session.Client.Transport.(*utils.RetryTransport).Timeout; timeout != 5*time.Second {
+		t.Errorf("expected a 5s timeout, got %s", timeout)
+	}
+	if _, err := client.Session.User("@me"); err != nil {
+		t.Fatal(err)
+	}
+	if proxied != 1 {
+		t.Errorf("expected the request to go through the proxy, got %d proxied requests", proxied)
+	}
+}
+
+func TestConfigClientDownload(t *testing.T) {
+	server := discordtest.NewServer()
+	defer server.Close()
+
+	var header http.Header
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		_, _ = w.Write([]byte("image"))
+	}))
+	defer files.Close()
+
+	config := Config{Token: "Bot test", BaseURL: server.URL, AuditLogReason: "Managed by Terraform"}
+	client, err := config.Client(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodPost, files.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.DownloadClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	for _, name := range []string{"Authorization", "X-Audit-Log-Reason"} {
+		if value := header.Get(name); value != "" {
+			t.Errorf("expected no %s header on downloads, got %q", name, value)
+		}
+	}
+}
+
+func TestConfigClientCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "1", "username": "terraform"}`))
+	}))
+	defer server.Close()
+	bundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	bundleFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(bundleFile, []byte(bundle), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{name: "untrusted", config: Config{}, wantErr: true},
+		{name: "bundle", config: Config{CABundle: bundle}},
+		{name: "bundle file", config: Config{CABundleFile: bundleFile}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.Token = "Bot test"
+			tt.config.BaseURL = server.URL
+			client, err := tt.config.Client(context.Background(), "test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = client.Session.User("@me")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("User() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigClientInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+	}{
+		{name: "relative api url", config: Config{BaseURL: "localhost:8080"}},
+		{name: "invalid proxy url", config: Config{ProxyURL: "http://[::1"}},
+		{name: "empty bundle", config: Config{CABundle: "not a certificate"}},
+		{name: "missing bundle file", config: Config{CABundleFile: filepath.Join(t.TempDir(), "missing.pem")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.Token = "Bot test"
+			if _, err := tt.config.Client(context.Background(), "test"); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestConfigClientRetries(t *testing.T) {
+	server := discordtest.NewServer()
+	defer server.Close()
+
+	config := Config{Token: "Bot test", BaseURL: server.URL, MaxRetries: 2, MaxRetryWait: time.Second}
+	client, err := config.Client(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.RateLimitNext(2)
+	if _, err := client.Session.User("@me"); err != nil {
+		t.Fatalf("expected rate limited requests to be retried, got %s", err)
+	}
+	server.RateLimitNext(3)
+	if _, err := client.Session.User("@me"); err == nil {
+		t.Fatal("expected an error once the retries are used up")
+	}
+}
+
+func TestConfigClientCredentials(t *testing.T) {
+	server := discordtest.NewServer()
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		config     Config
+		wantTokens int
+		wantErr    bool
+	}{
+		{name: "client credentials", config: Config{ClientID: server.ClientID, Secret: server.ClientSecret}, wantTokens: 1},
+		{name: "bot token first", config: Config{Token: "Bot test", ClientID: server.ClientID, Secret: server.ClientSecret}},
+		{name: "invalid secret", config: Config{ClientID: server.ClientID, Secret: "wrong"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := server.BearerTokens()
+			tt.config.BaseURL = server.URL
+			client, err := tt.config.Client(context.Background(), "test")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i := 0; i < 2; i++ {
+				_, err = client.Session.User("@me")
+			}
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if tokens := server.BearerTokens() - before; tokens != tt.wantTokens {
+				t.Errorf("got %d Bearer tokens, want %d", tokens, tt.wantTokens)
+			}
+		})
+	}
+}
+
+func TestContextIdentify(t *testing.T) {
+	server := discordtest.NewServer()
+	defer server.Close()
+	server.BotToken = "Bot test"
+
+	tests := []struct {
+		name             string
+		config           Config
+		wantBotUserID    string
+		wantIntents      discordgo.Intent
+		wantUnauthorized bool
+	}{
+		{
+			name:          "bot token",
+			config:        Config{Token: "Bot test"},
+			wantBotUserID: server.BotUser["id"].(string),
+			wantIntents:   discordgo.IntentGuildPresences | discordgo.IntentMessageContent,
+		},
+		{
+			name:        "client credentials",
+			config:      Config{ClientID: server.ClientID, Secret: server.ClientSecret},
+			wantIntents: discordgo.IntentGuildPresences | discordgo.IntentMessageContent,
+		},
+		{
+			name:             "invalid bot token",
+			config:           Config{Token: "Bot revoked"},
+			wantUnauthorized: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.BaseURL = server.URL
+			client, err := tt.config.Client(context.Background(), "test")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = client.Identify(context.Background())
+			if tt.wantUnauthorized {
+				var restErr *discordgo.RESTError
+				if !errors.As(err, &restErr) || restErr.Response.StatusCode != http.StatusUnauthorized {
+					t.Fatalf("expected a 401 error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if client.BotUserID != tt.wantBotUserID {
+				t.Errorf("got bot user ID %q, want %q", client.BotUserID, tt.wantBotUserID)
+			}
+			if client.ApplicationID != server.Application["id"] {
+				t.Errorf("got application ID %q, want %q", client.ApplicationID, server.Application["id"])
+			}
+			if client.PrivilegedIntents != tt.wantIntents {
+				t.Errorf("got intents %d, want %d", client.PrivilegedIntents, tt.wantIntents)
+			}
+		})
+	}
+}
+
+func TestTokenFromFile(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("Bot from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	emptyFile := filepath.Join(dir, "empty")
+	if err := os.WriteFile(emptyFile, []byte("\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		want    string
+		wantErr bool
+	}{
+		{name: "trailing newline", file: tokenFile, want: "Bot from-file"},
+		{name: "empty", file: emptyFile, wantErr: true},
+		{name: "missing", file: filepath.Join(dir, "missing"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tokenFromFile(tt.file)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got token %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenFromCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		command []string
+		want    string
+		wantErr string
+	}{
+		{name: "token", command: []string{"sh", "-c", "echo 'Bot from-command'"}, want: "Bot from-command"},
+		{name: "failure", command: []string{"sh", "-c", "echo 'Bot leaked'; echo 'not logged in' >&2; exit 1"}, wantErr: "not logged in"},
+		{name: "no output", command: []string{"true"}, wantErr: "did not print a token"},
+		{name: "timeout", command: []string{"sleep", "5"}, wantErr: "did not finish"},
+		{name: "missing program", command: []string{filepath.Join(t.TempDir(), "missing")}, wantErr: "no such file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tokenFromCommand(context.Background(), tt.command, 500*time.Millisecond)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want an error containing %q", err, tt.wantErr)
+				}
+				if strings.Contains(err.Error(), "leaked") {
+					t.Errorf("expected the command output to be left out of the error, got %q", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got token %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DiscordBans{}
+
+func NewDiscordBansDataSource() datasource.DataSource {
+	return &DiscordBans{}
+}
+
+type DiscordBansModel struct {
+	ServerID types.String           `tfsdk:"server_id"`
+	Bans     []DiscordBanEntryModel `tfsdk:"bans"`
+}
+
+type DiscordBanEntryModel struct {
+	UserID   types.String `tfsdk:"user_id"`
+	Username types.String `tfsdk:"username"`
+	Reason   types.String `tfsdk:"reason"`
+}
+
+type DiscordBans struct {
+	client *Context
+}
+
+func (r *DiscordBans) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bans"
+}
+
+func (r *DiscordBans) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DiscordBans) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Discord Bans Data Source",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Description: "The server ID. Defaults to the provider `default_server_id`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"bans": schema.ListNestedAttribute{
+				Description: "The bans of the server, ordered by user ID",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Description: "The ID of the banned user",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The username of the banned user",
+							Computed:    true,
+						},
+						"reason": schema.StringAttribute{
+							Description: "The reason for the ban",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DiscordBans) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DiscordBansModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var diags diag.Diagnostics
+	data.ServerID, diags = utils.ServerIDWithDefault(data.ServerID, r.client.Config.DefaultServerID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := data.ServerID.ValueString()
+	bans, err := utils.GetAllBans(r.client.Session, serverID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get bans of server %s", serverID), err.Error())
+		return
+	}
+	data.Bans = make([]DiscordBanEntryModel, 0, len(bans))
+	for _, ban := range bans {
+		data.Bans = append(data.Bans, DiscordBanEntryModel{
+			UserID:   types.StringValue(ban.User.ID),
+			Username: types.StringValue(ban.User.Username),
+			Reason:   utils.StringValueOrNull(ban.Reason),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
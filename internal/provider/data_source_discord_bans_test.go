@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDatasourceDiscordBans(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+
+	name := "data.discord_bans.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDiscordBans(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttrSet(name, "bans.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDiscordBans(serverId string) string {
+	return fmt.Sprintf(`
+	data "discord_bans" "example" {
+	  server_id = "%[1]s"
+	}`, serverId)
+}
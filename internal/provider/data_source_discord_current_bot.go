@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DiscordCurrentBotDataSource{}
+
+// privilegedIntentNames are the names Discord uses for the privileged gateway intents, in the order they are listed.
+var privilegedIntentNames = []struct {
+	intent discordgo.Intent
+	name   string
+}{
+	{discordgo.IntentGuildMembers, "GUILD_MEMBERS"},
+	{discordgo.IntentGuildPresences, "GUILD_PRESENCES"},
+	{discordgo.IntentMessageContent, "MESSAGE_CONTENT"},
+}
+
+func NewDiscordCurrentBotDataSource() datasource.DataSource {
+	return &DiscordCurrentBotDataSource{}
+}
+
+type DiscordCurrentBotModel struct {
+	ID                types.String   `tfsdk:"id"`
+	ApplicationID     types.String   `tfsdk:"application_id"`
+	PrivilegedIntents []types.String `tfsdk:"privileged_intents"`
+}
+
+type DiscordCurrentBotDataSource struct {
+	client *Context
+}
+
+func (d *DiscordCurrentBotDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_bot"
+}
+
+func (d *DiscordCurrentBotDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Describes the bot and application the provider is authenticated as.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The user ID of the bot. Empty when the provider only has OAuth2 client credentials.",
+				Computed:            true,
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the application",
+				Computed:            true,
+			},
+			"privileged_intents": schema.ListAttribute{
+				MarkdownDescription: "The privileged gateway intents enabled for the application. Any of `GUILD_MEMBERS`, `GUILD_PRESENCES` and `MESSAGE_CONTENT`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DiscordCurrentBotDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DiscordCurrentBotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Everything is known from configuring the provider, so there is nothing to request.
+	data := DiscordCurrentBotModel{
+		ID:                types.StringValue(d.client.BotUserID),
+		ApplicationID:     types.StringValue(d.client.ApplicationID),
+		PrivilegedIntents: []types.String{},
+	}
+	for _, intent := range privilegedIntentNames {
+		if d.client.PrivilegedIntents&intent.intent != 0 {
+			data.PrivilegedIntents = append(data.PrivilegedIntents, types.StringValue(intent.name))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -65,8 +66,9 @@ func (r *DiscordMember) Schema(ctx context.Context, req datasource.SchemaRequest
 		Description: "Discord Member Data Source",
 		Attributes: map[string]schema.Attribute{
 			"server_id": schema.StringAttribute{
-				Description: "The ID of the server to search for the member in.",
-				Required:    true,
+				Description: "The ID of the server to search for the member in. Defaults to the provider `default_server_id`.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"user_id": schema.StringAttribute{
 				Description: "The ID of the user to search for. Only one of `user_id` or `username` can be set.",
@@ -122,6 +124,13 @@ func (r *DiscordMember) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
+	serverID, diags := utils.ServerIDWithDefault(data.ServerID, r.client.Config.DefaultServerID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ServerID = serverID
+
 	var member *discordgo.Member
 	var memberErr error
 
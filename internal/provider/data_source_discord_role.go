@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -60,7 +61,9 @@ func (r *DiscordRoleDatasource) Schema(ctx context.Context, req datasource.Schem
 		Description: "Discord Role",
 		Attributes: map[string]schema.Attribute{
 			"server_id": schema.StringAttribute{
-				Required: true,
+				MarkdownDescription: "The server ID. Defaults to the provider `default_server_id`.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"id": schema.StringAttribute{
 				Optional: true,
@@ -98,6 +101,12 @@ func (r *DiscordRoleDatasource) Read(ctx context.Context, req datasource.ReadReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	serverID, diags := utils.ServerIDWithDefault(data.ServerID, r.client.Config.DefaultServerID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ServerID = serverID
 	client := r.client.Session
 	roles, err := client.GuildRoles(data.ServerID.ValueString(), discordgo.WithContext(ctx))
 	if err != nil {
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DiscordScheduledEventUsers{}
+
+func NewDiscordScheduledEventUsersDataSource() datasource.DataSource {
+	return &DiscordScheduledEventUsers{}
+}
+
+type DiscordScheduledEventUsersModel struct {
+	ServerID         types.String                          `tfsdk:"server_id"`
+	ScheduledEventID types.String                          `tfsdk:"scheduled_event_id"`
+	Users            []DiscordScheduledEventUserEntryModel `tfsdk:"users"`
+}
+
+type DiscordScheduledEventUserEntryModel struct {
+	UserID   types.String `tfsdk:"user_id"`
+	Username types.String `tfsdk:"username"`
+}
+
+type DiscordScheduledEventUsers struct {
+	client *Context
+}
+
+func (r *DiscordScheduledEventUsers) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_event_users"
+}
+
+func (r *DiscordScheduledEventUsers) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DiscordScheduledEventUsers) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Discord Scheduled Event Users Data Source",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Description: "The server ID. Defaults to the provider `default_server_id`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"scheduled_event_id": schema.StringAttribute{
+				Description: "The ID of the scheduled event",
+				Required:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The users interested in the scheduled event, ordered by user ID",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Description: "The ID of the user",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The username of the user",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DiscordScheduledEventUsers) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DiscordScheduledEventUsersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var diags diag.Diagnostics
+	data.ServerID, diags = utils.ServerIDWithDefault(data.ServerID, r.client.Config.DefaultServerID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventID := data.ScheduledEventID.ValueString()
+	users, err := utils.GetAllScheduledEventUsers(r.client.Session, data.ServerID.ValueString(), eventID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get users of scheduled event %s", eventID), err.Error())
+		return
+	}
+	data.Users = make([]DiscordScheduledEventUserEntryModel, 0, len(users))
+	for _, user := range users {
+		data.Users = append(data.Users, DiscordScheduledEventUserEntryModel{
+			UserID:   types.StringValue(user.User.ID),
+			Username: types.StringValue(user.User.Username),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
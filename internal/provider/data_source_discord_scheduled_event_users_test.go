@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDatasourceDiscordScheduledEventUsers(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testEventID := os.Getenv("DISCORD_TEST_SCHEDULED_EVENT_ID")
+	testUserID := os.Getenv("DISCORD_TEST_USER_ID")
+	if testServerID == "" || testEventID == "" || testUserID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID, DISCORD_TEST_SCHEDULED_EVENT_ID and DISCORD_TEST_USER_ID envvars must be set for acceptance tests")
+	}
+
+	name := "data.discord_scheduled_event_users.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDiscordScheduledEventUsers(testServerID, testEventID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "users.#", "1"),
+					resource.TestCheckResourceAttr(name, "users.0.user_id", testUserID),
+					resource.TestCheckResourceAttr(name, "users.0.username", os.Getenv("DISCORD_TEST_USERNAME")),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDiscordScheduledEventUsers(serverID string, eventID string) string {
+	return fmt.Sprintf(`
+	data "discord_scheduled_event_users" "example" {
+	  server_id = "%[1]s"
+	  scheduled_event_id = "%[2]s"
+	}`, serverID, eventID)
+}
@@ -51,14 +51,15 @@ func (r *DiscordServerDatasource) Schema(ctx context.Context, req datasource.Sch
 		MarkdownDescription: "Discord Server Data Source.\n This data source can only fetch up to 1000 servers.",
 		Attributes: map[string]schema.Attribute{
 			"server_id": schema.StringAttribute{
-				Description: "ID of the server. Only one of `server_id` or `name` can be set.",
+				Description: "ID of the server. Only one of `server_id` or `name` can be set. Defaults to the provider `default_server_id` when neither is set.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"name": schema.StringAttribute{
 				Description: "Name of the server. Only one of `server_id` or `name` can be set.",
 				Optional:    true,
 				Validators: []validator.String{
-					stringvalidator.ExactlyOneOf(path.MatchRoot("server_id")),
+					stringvalidator.ConflictsWith(path.MatchRoot("server_id")),
 				},
 			},
 			"region": schema.StringAttribute{
@@ -130,8 +131,16 @@ func (r *DiscordServerDatasource) Read(ctx context.Context, req datasource.ReadR
 	var err error
 
 	client := r.client.Session
-	serverID := data.ServerID.ValueString()
 	serverName := data.Name.ValueString()
+	if serverName == "" {
+		serverID, diags := utils.ServerIDWithDefault(data.ServerID, r.client.Config.DefaultServerID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.ServerID = serverID
+	}
+	serverID := data.ServerID.ValueString()
 	if serverID != "" {
 		guild, err = client.Guild(serverID, discordgo.WithContext(ctx))
 		if err != nil {
@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -53,8 +55,9 @@ func (r *DiscordSystemChannel) Schema(ctx context.Context, req datasource.Schema
 		Description: "Discord System Channel Data Source",
 		Attributes: map[string]schema.Attribute{
 			"server_id": schema.StringAttribute{
-				Description: "The server ID",
-				Required:    true,
+				Description: "The server ID. Defaults to the provider `default_server_id`.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"system_channel_id": schema.StringAttribute{
 				Description: "The system channel ID",
@@ -73,8 +76,15 @@ func (r *DiscordSystemChannel) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 	var err error
+	var diags diag.Diagnostics
 	var server *discordgo.Guild
 
+	data.ServerID, diags = utils.ServerIDWithDefault(data.ServerID, r.client.Config.DefaultServerID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	client := r.client.Session
 	serverID := data.ServerID.ValueString()
 	server, err = client.Guild(serverID)
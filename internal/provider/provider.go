@@ -2,13 +2,23 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
 	"os"
+	"time"
 )
 
 // Ensure DiscordProvider satisfies various provider interfaces.
@@ -25,9 +35,26 @@ type DiscordProvider struct {
 
 // DiscordProviderModel describes the provider data model.
 type DiscordProviderModel struct {
-	Token    types.String `tfsdk:"token"`
-	ClientID types.String `tfsdk:"client_id"`
-	Secret   types.String `tfsdk:"secret"`
+	Token        types.String   `tfsdk:"token"`
+	TokenFile    types.String   `tfsdk:"token_file"`
+	TokenCommand []types.String `tfsdk:"token_command"`
+	ClientID     types.String   `tfsdk:"client_id"`
+	Secret       types.String   `tfsdk:"secret"`
+
+	OAuth2Scopes []types.String `tfsdk:"oauth2_scopes"`
+
+	APIURL         types.String `tfsdk:"api_url"`
+	ProxyURL       types.String `tfsdk:"proxy_url"`
+	CABundle       types.String `tfsdk:"ca_bundle"`
+	CABundleFile   types.String `tfsdk:"ca_bundle_file"`
+	RequestTimeout types.Int64  `tfsdk:"request_timeout"`
+
+	MaxRetries            types.Int64 `tfsdk:"max_retries"`
+	MaxRetryWait          types.Int64 `tfsdk:"max_retry_wait"`
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+
+	AuditLogReason  types.String `tfsdk:"audit_log_reason"`
+	DefaultServerID types.String `tfsdk:"default_server_id"`
 }
 
 func (p *DiscordProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -39,14 +66,97 @@ func (p *DiscordProvider) Schema(ctx context.Context, req provider.SchemaRequest
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"token": schema.StringAttribute{
-				MarkdownDescription: "Discord API Token. This can be found in the Discord Developer Portal. This includes the `Bot` prefix. Can also be set via the `DISCORD_TOKEN` environment variable.",
+				MarkdownDescription: "Discord API Token. This can be found in the Discord Developer Portal. This includes the `Bot` prefix. Can also be set via the `DISCORD_TOKEN` environment variable. Not required when `client_id` and `secret` are set. Conflicts with `token_file` and `token_command`.",
 				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token_file"), path.MatchRoot("token_command")),
+				},
+			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the Discord API token, such as a mounted secret. Surrounding whitespace is ignored. Conflicts with `token` and `token_command`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token"), path.MatchRoot("token_command")),
+				},
+			},
+			"token_command": schema.ListAttribute{
+				MarkdownDescription: "Command that prints the Discord API token, given as the program followed by its arguments. It is run without a shell and must exit within `request_timeout`. Conflicts with `token` and `token_file`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("token"), path.MatchRoot("token_file")),
+				},
 			},
 			"client_id": schema.StringAttribute{
-				Optional: true,
+				MarkdownDescription: "OAuth2 client ID of the application, used with `secret` to get Bearer tokens with the client credentials grant. Without a `token` every request uses the Bearer token, otherwise only the endpoints that require one do. Can also be set via the `DISCORD_CLIENT_ID` environment variable.",
+				Optional:            true,
 			},
 			"secret": schema.StringAttribute{
-				Optional: true,
+				MarkdownDescription: "OAuth2 client secret of the application. Can also be set via the `DISCORD_CLIENT_SECRET` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"oauth2_scopes": schema.ListAttribute{
+				MarkdownDescription: "Scopes requested with the client credentials grant. Defaults to `identify` and `applications.commands.update`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the Discord API, for routing requests through a gateway or mock. Defaults to `https://discord.com/`. Can also be set via the `DISCORD_API_URL` environment variable.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of an HTTP or HTTPS proxy to send API requests through. Defaults to the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"ca_bundle": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates to trust in addition to the system certificates.",
+				Optional:            true,
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file of PEM encoded CA certificates to trust in addition to the system certificates.",
+				Optional:            true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds for each API request. Defaults to `20`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "How many times a request that was rate limited, or that failed with a server error and is safe to repeat, is retried. Defaults to `5`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_retry_wait": schema.Int64Attribute{
+				MarkdownDescription: "Longest time in seconds to wait before a retry. Requests that Discord asks to wait longer for fail instead. Defaults to `60`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of API requests in flight at once. Defaults to `0`, which means no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"audit_log_reason": schema.StringAttribute{
+				MarkdownDescription: "Reason shown in the server audit log for every change made by the provider. Resources can override it with their own `audit_log_reason`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			"default_server_id": schema.StringAttribute{
+				MarkdownDescription: "Server ID used by every resource and data source that does not set `server_id`.",
+				Optional:            true,
 			},
 		},
 	}
@@ -60,25 +170,105 @@ func (p *DiscordProvider) Configure(ctx context.Context, req provider.ConfigureR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// token, token_file and token_command conflict with each other, DISCORD_TOKEN is only used when none is set.
 	token := data.Token.ValueString()
-	if token == "" {
+	switch {
+	case data.TokenFile.ValueString() != "":
+		fileToken, err := tokenFromFile(data.TokenFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("token_file"), "failed to read token file", err.Error())
+			return
+		}
+		token = fileToken
+	case len(data.TokenCommand) > 0:
+		command := make([]string, 0, len(data.TokenCommand))
+		for _, arg := range data.TokenCommand {
+			command = append(command, arg.ValueString())
+		}
+		timeout := defaultRequestTimeout
+		if !data.RequestTimeout.IsNull() {
+			timeout = time.Duration(data.RequestTimeout.ValueInt64()) * time.Second
+		}
+		commandToken, err := tokenFromCommand(ctx, command, timeout)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("token_command"), "failed to run token command", err.Error())
+			return
+		}
+		token = commandToken
+	case token == "":
 		token = os.Getenv("DISCORD_TOKEN")
 	}
-	if token == "" {
-		resp.Diagnostics.AddError("missing required token", "the `token` argument or `DISCORD_TOKEN` environment variable must be set")
+	clientID := data.ClientID.ValueString()
+	if clientID == "" {
+		clientID = os.Getenv("DISCORD_CLIENT_ID")
+	}
+	secret := data.Secret.ValueString()
+	if secret == "" {
+		secret = os.Getenv("DISCORD_CLIENT_SECRET")
+	}
+	if (clientID == "") != (secret == "") {
+		resp.Diagnostics.AddError("incomplete client credentials", "`client_id` and `secret` must be set together")
+		return
+	}
+	// Keep the credentials out of every log line written while configuring, including retries logged later on.
+	for _, credential := range []string{token, secret} {
+		if credential != "" {
+			ctx = tflog.MaskLogStrings(ctx, credential)
+		}
+	}
+	if token == "" && clientID == "" {
+		resp.Diagnostics.AddError("missing required token", "one of `token`, `token_file`, `token_command` or the `DISCORD_TOKEN` environment variable must be set, or `client_id` and `secret` for OAuth2 authentication")
 		return
 	}
 	config := Config{
 		Token:    token,
-		ClientID: data.ClientID.ValueString(),
-		Secret:   data.Secret.ValueString(),
+		ClientID: clientID,
+		Secret:   secret,
+
+		BaseURL:        data.APIURL.ValueString(),
+		ProxyURL:       data.ProxyURL.ValueString(),
+		CABundle:       data.CABundle.ValueString(),
+		CABundleFile:   data.CABundleFile.ValueString(),
+		RequestTimeout: time.Duration(data.RequestTimeout.ValueInt64()) * time.Second,
+
+		MaxRetries:            defaultMaxRetries,
+		MaxRetryWait:          defaultMaxRetryWait,
+		MaxConcurrentRequests: int(data.MaxConcurrentRequests.ValueInt64()),
+
+		AuditLogReason:  data.AuditLogReason.ValueString(),
+		DefaultServerID: data.DefaultServerID.ValueString(),
+	}
+	if !data.MaxRetries.IsNull() {
+		config.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	if !data.MaxRetryWait.IsNull() {
+		config.MaxRetryWait = time.Duration(data.MaxRetryWait.ValueInt64()) * time.Second
+	}
+	for _, scope := range data.OAuth2Scopes {
+		config.OAuth2Scopes = append(config.OAuth2Scopes, scope.ValueString())
+	}
+	if config.BaseURL == "" {
+		config.BaseURL = os.Getenv("DISCORD_API_URL")
 	}
 
-	client, err := config.Client(p.version)
+	client, err := config.Client(ctx, p.version)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create Discord client", err.Error())
 		return
 	}
+	if err := client.Identify(ctx); err != nil {
+		var restErr *discordgo.RESTError
+		if errors.As(err, &restErr) && restErr.Response.StatusCode == http.StatusUnauthorized {
+			resp.Diagnostics.AddError("invalid Discord credentials", "Discord rejected the configured credentials. Check that the token, or the `client_id` and `secret`, are correct and have not been reset.")
+			return
+		}
+		resp.Diagnostics.AddError("failed to validate Discord credentials", err.Error())
+		return
+	}
+	tflog.Info(ctx, "Configured Discord client", map[string]interface{}{
+		"bot_user_id":    client.BotUserID,
+		"application_id": client.ApplicationID,
+	})
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
@@ -94,10 +284,27 @@ func (p *DiscordProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewDiscordNewsChannelResource,
 		NewDiscordCategoryChannelResource,
 		NewDiscordTextChannelResource,
-		//NewDiscordEveryoneRoleResource,
+		NewDiscordForumChannelResource,
+		NewDiscordStageChannelResource,
+		NewDiscordMediaChannelResource,
+		NewDiscordEveryoneRoleResource,
 		NewDiscordSystemChannelResource,
 		NewDiscordWebhookResource,
 		NewDiscordChannelPermissionResource,
+		NewDiscordThreadResource,
+		NewDiscordForumPostResource,
+		NewDiscordMemberRolesResource,
+		NewDiscordMemberResource,
+		NewDiscordBanResource,
+		NewDiscordEmojiResource,
+		NewDiscordStickerResource,
+		NewDiscordScheduledEventResource,
+		NewDiscordAutoModRuleResource,
+		NewDiscordWelcomeScreenResource,
+		NewDiscordServerOnboardingResource,
+		NewDiscordServerTemplateResource,
+		NewDiscordApplicationCommandResource,
+		NewDiscordApplicationCommandsResource,
 	}
 }
 
@@ -110,6 +317,9 @@ func (p *DiscordProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewDiscordPermissionDataSource,
 		NewDiscordServerDataSource,
 		NewDiscordSystemChannelDataSource,
+		NewDiscordCurrentBotDataSource,
+		NewDiscordBansDataSource,
+		NewDiscordScheduledEventUsersDataSource,
 	}
 }
 
@@ -1,10 +1,20 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/discordtest"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"log"
 	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
+	"time"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -15,6 +25,106 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"discord": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// TestMain runs the acceptance tests against an in-memory Discord API when DISCORD_TOKEN is not set.
+func TestMain(m *testing.M) {
+	if os.Getenv("DISCORD_TOKEN") != "" {
+		os.Exit(m.Run())
+	}
+
+	server := discordtest.NewServer()
+	if err := seedTestServer(server); err != nil {
+		server.Close()
+		log.Fatalf("failed to seed the test Discord API: %s", err)
+	}
+
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
+// seedTestServer creates the fixtures the acceptance tests read from the DISCORD_TEST_* environment variables.
+func seedTestServer(server *discordtest.Server) error {
+	config := Config{Token: "Bot discord-terraform-test", BaseURL: server.URL}
+	client, err := config.Client(context.Background(), "test")
+	if err != nil {
+		return err
+	}
+
+	serverID := server.AddGuild("Discord Terraform Test Server")
+	// Welcome screens are only available to community servers.
+	server.AddGuildFeature(serverID, string(discordgo.GuildFeatureCommunity))
+	verificationLevel := discordgo.VerificationLevelLow
+	if _, err := client.Session.GuildEdit(serverID, &discordgo.GuildParams{
+		VerificationLevel:           &verificationLevel,
+		DefaultMessageNotifications: int(discordgo.MessageNotificationsOnlyMentions),
+		ExplicitContentFilter:       int(discordgo.ExplicitContentFilterAllMembers),
+		AfkTimeout:                  300,
+	}); err != nil {
+		return err
+	}
+	role, err := client.Session.GuildRoleCreate(serverID, &discordgo.RoleParams{Name: "terraform-test-role"})
+	if err != nil {
+		return err
+	}
+	channelID := server.AddChannel(serverID, "terraform-test", discordgo.ChannelTypeGuildText)
+	username := "terraform-test-user"
+	userID := server.AddMember(serverID, username)
+	voiceChannelID := server.AddChannel(serverID, "terraform-test-voice", discordgo.ChannelTypeGuildVoice)
+	voiceUserID := server.AddMember(serverID, "terraform-test-voice-user")
+	server.ConnectVoice(serverID, voiceUserID, voiceChannelID)
+	banUserID := server.AddMember(serverID, "terraform-test-ban-user")
+	start := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	end := start.Add(time.Hour)
+	event, err := utils.ScheduledEventCreateComplex(client.Session, serverID, &utils.ScheduledEventParams{
+		Name:               "terraform-test-event",
+		EntityType:         discordgo.GuildScheduledEventEntityTypeExternal,
+		EntityMetadata:     &discordgo.GuildScheduledEventEntityMetadata{Location: "Terraform"},
+		ScheduledStartTime: start,
+		ScheduledEndTime:   &end,
+		PrivacyLevel:       discordgo.GuildScheduledEventPrivacyLevelGuildOnly,
+	})
+	if err != nil {
+		return err
+	}
+	server.SubscribeScheduledEvent(event.ID, userID)
+
+	env := map[string]string{
+		"DISCORD_TOKEN":           config.Token,
+		"DISCORD_API_URL":         server.URL,
+		"DISCORD_TEST_SERVER_ID":  serverID,
+		"DISCORD_TEST_CHANNEL_ID": channelID,
+		"DISCORD_TEST_ROLE_ID":    role.ID,
+		"DISCORD_TEST_ROLE_NAME":  role.Name,
+		"DISCORD_TEST_USER_ID":    userID,
+		"DISCORD_TEST_USERNAME":   username,
+		"DISCORD_TEST_AVATAR_URL": server.ImageURL(),
+		// A member connected to a voice channel, for the voice settings of discord_member.
+		"DISCORD_TEST_VOICE_USER_ID": voiceUserID,
+		// A member that is banned by the discord_ban tests.
+		"DISCORD_TEST_BAN_USER_ID": banUserID,
+		// A scheduled event the DISCORD_TEST_USER_ID user is interested in.
+		"DISCORD_TEST_SCHEDULED_EVENT_ID": event.ID,
+	}
+	for key, value := range env {
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// testAccClient returns a client for changing Discord behind Terraform's back in acceptance tests.
+func testAccClient(t *testing.T) *Context {
+	config := Config{Token: os.Getenv("DISCORD_TOKEN"), BaseURL: os.Getenv("DISCORD_API_URL")}
+	client, err := config.Client(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
 func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
@@ -23,3 +133,77 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("DISCORD_TOKEN must be set for acceptance tests")
 	}
 }
+
+func TestAccProviderTokenFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte(os.Getenv("DISCORD_TOKEN")+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				provider "discord" {
+				  token      = "Bot test"
+				  token_file = %q
+				}
+
+				data "discord_current_bot" "example" {}`, tokenFile),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: fmt.Sprintf(`
+				provider "discord" {
+				  token_file = %q
+				}
+
+				data "discord_current_bot" "example" {}`, tokenFile),
+				Check: resource.TestCheckResourceAttrSet("data.discord_current_bot.example", "application_id"),
+			},
+		},
+	})
+}
+
+func TestAccProviderDefaultServerID(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+
+	name := "discord_role.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "discord_role" "example" {
+				  name = "terraform-default-server-role"
+				}`,
+				ExpectError: regexp.MustCompile(`missing server ID`),
+			},
+			{
+				Config: fmt.Sprintf(`
+				provider "discord" {
+				  default_server_id = %q
+				}
+
+				resource "discord_role" "example" {
+				  name        = "terraform-default-server-role"
+				  color       = 0
+				  permissions = 0
+				}
+
+				data "discord_system_channel" "example" {}`, testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttr("data.discord_system_channel.example", "server_id", testServerID),
+				),
+			},
+		},
+	})
+}
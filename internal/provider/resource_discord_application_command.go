@@ -0,0 +1,1080 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// applicationCommandTypes are the values of the command type attribute.
+var applicationCommandTypes = map[discordgo.ApplicationCommandType]string{
+	discordgo.ChatApplicationCommand:    "chat_input",
+	discordgo.UserApplicationCommand:    "user",
+	discordgo.MessageApplicationCommand: "message",
+}
+
+// applicationCommandOptionTypes are the values of the option type attribute. Subcommands and subcommand groups have
+// their own blocks.
+var applicationCommandOptionTypes = map[discordgo.ApplicationCommandOptionType]string{
+	discordgo.ApplicationCommandOptionString:      "string",
+	discordgo.ApplicationCommandOptionInteger:     "integer",
+	discordgo.ApplicationCommandOptionBoolean:     "boolean",
+	discordgo.ApplicationCommandOptionUser:        "user",
+	discordgo.ApplicationCommandOptionChannel:     "channel",
+	discordgo.ApplicationCommandOptionRole:        "role",
+	discordgo.ApplicationCommandOptionMentionable: "mentionable",
+	discordgo.ApplicationCommandOptionNumber:      "number",
+	discordgo.ApplicationCommandOptionAttachment:  "attachment",
+}
+
+// applicationCommandChannelTypes are the values of the channel_types attribute.
+var applicationCommandChannelTypes = map[discordgo.ChannelType]string{
+	discordgo.ChannelTypeGuildText:          "text",
+	discordgo.ChannelTypeGuildVoice:         "voice",
+	discordgo.ChannelTypeGuildCategory:      "category",
+	discordgo.ChannelTypeGuildNews:          "news",
+	discordgo.ChannelTypeGuildNewsThread:    "news_thread",
+	discordgo.ChannelTypeGuildPublicThread:  "public_thread",
+	discordgo.ChannelTypeGuildPrivateThread: "private_thread",
+	discordgo.ChannelTypeGuildStageVoice:    "stage",
+	discordgo.ChannelTypeGuildForum:         "forum",
+	discordgo.ChannelTypeGuildMedia:         "media",
+}
+
+// applicationIntegrationTypes are the values of the integration_types attribute.
+var applicationIntegrationTypes = map[utils.ApplicationIntegrationType]string{
+	utils.ApplicationIntegrationGuildInstall: "guild_install",
+	utils.ApplicationIntegrationUserInstall:  "user_install",
+}
+
+// interactionContextTypes are the values of the contexts attribute.
+var interactionContextTypes = map[utils.InteractionContextType]string{
+	utils.InteractionContextGuild:          "guild",
+	utils.InteractionContextBotDM:          "bot_dm",
+	utils.InteractionContextPrivateChannel: "private_channel",
+}
+
+// chatInputNameRegex matches the names of chat input commands and their options, which Discord needs in lowercase.
+var chatInputNameRegex = regexp.MustCompile(`^[-_\p{Ll}\p{Lm}\p{Lo}\p{N}]{1,32}$`)
+
+// Discord allows at most 25 options, subcommands and choices in each list.
+const applicationCommandMaxOptions = 25
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordApplicationCommandResource{}
+var _ resource.ResourceWithImportState = &DiscordApplicationCommandResource{}
+var _ resource.ResourceWithValidateConfig = &DiscordApplicationCommandResource{}
+
+func NewDiscordApplicationCommandResource() resource.Resource {
+	return &DiscordApplicationCommandResource{}
+}
+
+type DiscordApplicationCommandResource struct {
+	client *Context
+}
+
+type DiscordApplicationCommandModel struct {
+	ID                       types.String                                    `tfsdk:"id"`
+	ApplicationID            types.String                                    `tfsdk:"application_id"`
+	ServerID                 types.String                                    `tfsdk:"server_id"`
+	Type                     types.String                                    `tfsdk:"type"`
+	Name                     types.String                                    `tfsdk:"name"`
+	NameLocalizations        types.Map                                       `tfsdk:"name_localizations"`
+	Description              types.String                                    `tfsdk:"description"`
+	DescriptionLocalizations types.Map                                       `tfsdk:"description_localizations"`
+	DefaultMemberPermissions types.Int64                                     `tfsdk:"default_member_permissions"`
+	NSFW                     types.Bool                                      `tfsdk:"nsfw"`
+	IntegrationTypes         types.Set                                       `tfsdk:"integration_types"`
+	Contexts                 types.Set                                       `tfsdk:"contexts"`
+	Option                   []DiscordApplicationCommandOptionModel          `tfsdk:"option"`
+	Subcommand               []DiscordApplicationCommandSubcommandModel      `tfsdk:"subcommand"`
+	SubcommandGroup          []DiscordApplicationCommandSubcommandGroupModel `tfsdk:"subcommand_group"`
+}
+
+// DiscordApplicationCommandsCommandModel is a command without its scope, as in the command blocks of
+// discord_application_commands.
+type DiscordApplicationCommandsCommandModel struct {
+	ID                       types.String                                    `tfsdk:"id"`
+	Type                     types.String                                    `tfsdk:"type"`
+	Name                     types.String                                    `tfsdk:"name"`
+	NameLocalizations        types.Map                                       `tfsdk:"name_localizations"`
+	Description              types.String                                    `tfsdk:"description"`
+	DescriptionLocalizations types.Map                                       `tfsdk:"description_localizations"`
+	DefaultMemberPermissions types.Int64                                     `tfsdk:"default_member_permissions"`
+	NSFW                     types.Bool                                      `tfsdk:"nsfw"`
+	IntegrationTypes         types.Set                                       `tfsdk:"integration_types"`
+	Contexts                 types.Set                                       `tfsdk:"contexts"`
+	Option                   []DiscordApplicationCommandOptionModel          `tfsdk:"option"`
+	Subcommand               []DiscordApplicationCommandSubcommandModel      `tfsdk:"subcommand"`
+	SubcommandGroup          []DiscordApplicationCommandSubcommandGroupModel `tfsdk:"subcommand_group"`
+}
+
+type DiscordApplicationCommandSubcommandGroupModel struct {
+	Name                     types.String                               `tfsdk:"name"`
+	NameLocalizations        types.Map                                  `tfsdk:"name_localizations"`
+	Description              types.String                               `tfsdk:"description"`
+	DescriptionLocalizations types.Map                                  `tfsdk:"description_localizations"`
+	Subcommand               []DiscordApplicationCommandSubcommandModel `tfsdk:"subcommand"`
+}
+
+type DiscordApplicationCommandSubcommandModel struct {
+	Name                     types.String                           `tfsdk:"name"`
+	NameLocalizations        types.Map                              `tfsdk:"name_localizations"`
+	Description              types.String                           `tfsdk:"description"`
+	DescriptionLocalizations types.Map                              `tfsdk:"description_localizations"`
+	Option                   []DiscordApplicationCommandOptionModel `tfsdk:"option"`
+}
+
+type DiscordApplicationCommandOptionModel struct {
+	Type                     types.String                           `tfsdk:"type"`
+	Name                     types.String                           `tfsdk:"name"`
+	NameLocalizations        types.Map                              `tfsdk:"name_localizations"`
+	Description              types.String                           `tfsdk:"description"`
+	DescriptionLocalizations types.Map                              `tfsdk:"description_localizations"`
+	Required                 types.Bool                             `tfsdk:"required"`
+	Autocomplete             types.Bool                             `tfsdk:"autocomplete"`
+	MinValue                 types.Float64                          `tfsdk:"min_value"`
+	MaxValue                 types.Float64                          `tfsdk:"max_value"`
+	MinLength                types.Int64                            `tfsdk:"min_length"`
+	MaxLength                types.Int64                            `tfsdk:"max_length"`
+	ChannelTypes             types.Set                              `tfsdk:"channel_types"`
+	Choice                   []DiscordApplicationCommandChoiceModel `tfsdk:"choice"`
+}
+
+type DiscordApplicationCommandChoiceModel struct {
+	Name              types.String `tfsdk:"name"`
+	NameLocalizations types.Map    `tfsdk:"name_localizations"`
+	Value             types.String `tfsdk:"value"`
+}
+
+func (r *DiscordApplicationCommandResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_command"
+}
+
+func (r *DiscordApplicationCommandResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := applicationCommandAttributes(false)
+	attributes["application_id"] = schema.StringAttribute{
+		Description: "The ID of the application the command belongs to",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["server_id"] = schema.StringAttribute{
+		Description: "The ID of the server the command is registered in. Commands without one are global. The provider `default_server_id` is not used. Changing it replaces the command",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Application Command Resource",
+		Attributes:          attributes,
+		Blocks:              applicationCommandBlocks(),
+	}
+}
+
+// applicationCommandAttributes returns the attributes of a command. The commands of discord_application_commands
+// are all overwritten together, so their ID is planned by the resource and a new type does not replace anything.
+func applicationCommandAttributes(bulk bool) map[string]schema.Attribute {
+	id := schema.StringAttribute{
+		Description: "The ID of the command",
+		Computed:    true,
+	}
+	commandType := schema.StringAttribute{
+		Description: "The type of the command. One of `chat_input` for slash commands, and `user` and `message` for the context menu commands of users and messages",
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString("chat_input"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("chat_input", "user", "message"),
+		},
+	}
+	integrationTypes := schema.SetAttribute{
+		Description: "Where the application must be installed for the command to be available. Any of `guild_install` and `user_install`. Defaults to the installation contexts of the application",
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(stringvalidator.OneOf("guild_install", "user_install")),
+		},
+	}
+	contexts := schema.SetAttribute{
+		Description: "Where the command can be used. Any of `guild`, `bot_dm` and `private_channel`. Only for global commands, which Discord allows everywhere by default",
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(stringvalidator.OneOf("guild", "bot_dm", "private_channel")),
+		},
+	}
+	if !bulk {
+		id.PlanModifiers = []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+		commandType.Description += ". Changing it replaces the command"
+		commandType.PlanModifiers = []planmodifier.String{stringplanmodifier.RequiresReplace()}
+		integrationTypes.PlanModifiers = []planmodifier.Set{setplanmodifier.UseStateForUnknown()}
+		contexts.PlanModifiers = []planmodifier.Set{setplanmodifier.UseStateForUnknown()}
+	}
+
+	return map[string]schema.Attribute{
+		"id":   id,
+		"type": commandType,
+		"name": schema.StringAttribute{
+			Description: "The name of the command. Names of `chat_input` commands must be lowercase and without spaces",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 32),
+			},
+		},
+		"name_localizations": applicationCommandLocalizationsAttribute("name", 32),
+		"description": schema.StringAttribute{
+			Description: "The description of the command. Required for `chat_input` commands, and cannot be set on other commands",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 100),
+			},
+		},
+		"description_localizations": applicationCommandLocalizationsAttribute("description", 100),
+		"default_member_permissions": schema.Int64Attribute{
+			Description: "The permissions members need to use the command, unless the server overrides them. `0` only allows administrators. Everyone can use the command when not set",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"nsfw": schema.BoolAttribute{
+			Description: "Whether the command is age-restricted",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"integration_types": integrationTypes,
+		"contexts":          contexts,
+	}
+}
+
+// applicationCommandLocalizationsAttribute returns an attribute with the translations of a name or description by
+// locale.
+func applicationCommandLocalizationsAttribute(field string, maxLength int) schema.MapAttribute {
+	locales := make([]string, 0, len(discordgo.Locales))
+	for locale := range discordgo.Locales {
+		if locale != discordgo.Unknown {
+			locales = append(locales, string(locale))
+		}
+	}
+	sort.Strings(locales)
+
+	return schema.MapAttribute{
+		Description: fmt.Sprintf("The %s in other languages, by Discord locale such as `de` or `pt-BR`", field),
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.Map{
+			mapvalidator.SizeAtLeast(1),
+			mapvalidator.KeysAre(stringvalidator.OneOf(locales...)),
+			mapvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, maxLength)),
+		},
+	}
+}
+
+// applicationCommandNameAttributes returns the name and description attributes of an option, subcommand or
+// subcommand group.
+func applicationCommandNameAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: fmt.Sprintf("The name of the %s, in lowercase and without spaces", kind),
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(chatInputNameRegex, "must be 1 to 32 lowercase letters, numbers, dashes and underscores"),
+			},
+		},
+		"name_localizations": applicationCommandLocalizationsAttribute("name", 32),
+		"description": schema.StringAttribute{
+			Description: fmt.Sprintf("The description of the %s", kind),
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 100),
+			},
+		},
+		"description_localizations": applicationCommandLocalizationsAttribute("description", 100),
+	}
+}
+
+// applicationCommandBlocks returns the options, subcommands and subcommand groups of a command. A command has either
+// options, or subcommands and subcommand groups.
+func applicationCommandBlocks() map[string]schema.Block {
+	subcommand := schema.ListNestedBlock{
+		Description: fmt.Sprintf("A subcommand, at most %d", applicationCommandMaxOptions),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(applicationCommandMaxOptions),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: applicationCommandNameAttributes("subcommand"),
+			Blocks: map[string]schema.Block{
+				"option": applicationCommandOptionBlock(),
+			},
+		},
+	}
+
+	return map[string]schema.Block{
+		"option":     applicationCommandOptionBlock(),
+		"subcommand": subcommand,
+		"subcommand_group": schema.ListNestedBlock{
+			Description: fmt.Sprintf("A group of subcommands, at most %d", applicationCommandMaxOptions),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(applicationCommandMaxOptions),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: applicationCommandNameAttributes("subcommand group"),
+				Blocks: map[string]schema.Block{
+					"subcommand": schema.ListNestedBlock{
+						Description: fmt.Sprintf("A subcommand of the group. At least one is required, at most %d", applicationCommandMaxOptions),
+						Validators: []validator.List{
+							listvalidator.IsRequired(),
+							listvalidator.SizeBetween(1, applicationCommandMaxOptions),
+						},
+						NestedObject: subcommand.NestedObject,
+					},
+				},
+			},
+		},
+	}
+}
+
+func applicationCommandOptionBlock() schema.ListNestedBlock {
+	attributes := applicationCommandNameAttributes("option")
+	attributes["type"] = schema.StringAttribute{
+		Description: "The type of the value. One of `string`, `integer`, `boolean`, `user`, `channel`, `role`, `mentionable`, `number` and `attachment`",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("string", "integer", "boolean", "user", "channel", "role", "mentionable", "number", "attachment"),
+		},
+	}
+	attributes["required"] = schema.BoolAttribute{
+		Description: "Whether the option must be given. Required options must come before the others",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+	}
+	attributes["autocomplete"] = schema.BoolAttribute{
+		Description: "Whether the application suggests values as they are typed. Only for `string`, `integer` and `number` options without choices",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+	}
+	attributes["min_value"] = schema.Float64Attribute{
+		Description: "The smallest value allowed. Only for `integer` and `number` options",
+		Optional:    true,
+		Validators: []validator.Float64{
+			float64validator.Between(-(1 << 53), 1<<53),
+		},
+	}
+	attributes["max_value"] = schema.Float64Attribute{
+		Description: "The largest value allowed. Only for `integer` and `number` options",
+		Optional:    true,
+		Validators: []validator.Float64{
+			float64validator.Between(-(1 << 53), 1<<53),
+		},
+	}
+	attributes["min_length"] = schema.Int64Attribute{
+		Description: "The shortest value allowed. Only for `string` options",
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.Between(0, 6000),
+		},
+	}
+	attributes["max_length"] = schema.Int64Attribute{
+		Description: "The longest value allowed. Only for `string` options",
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, 6000),
+		},
+	}
+	attributes["channel_types"] = schema.SetAttribute{
+		Description: "The types of the channels that can be picked. Any of `text`, `voice`, `category`, `news`, `news_thread`, `public_thread`, `private_thread`, `stage`, `forum` and `media`. Only for `channel` options, which allow every type when not set",
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(stringvalidator.OneOf("text", "voice", "category", "news", "news_thread", "public_thread", "private_thread", "stage", "forum", "media")),
+		},
+	}
+
+	return schema.ListNestedBlock{
+		Description: fmt.Sprintf("A value given with the command, at most %d", applicationCommandMaxOptions),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(applicationCommandMaxOptions),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks: map[string]schema.Block{
+				"choice": schema.ListNestedBlock{
+					Description: fmt.Sprintf("A value the option is limited to, at most %d. Only for `string`, `integer` and `number` options", applicationCommandMaxOptions),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(applicationCommandMaxOptions),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Description: "The name of the choice shown to members",
+								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 100),
+								},
+							},
+							"name_localizations": applicationCommandLocalizationsAttribute("name", 100),
+							"value": schema.StringAttribute{
+								Description: "The value of the choice. Values of `integer` and `number` options are written as numbers, such as `\"10\"` or `\"2.5\"`",
+								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 100),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DiscordApplicationCommandResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the command against what Discord allows for its type, so that a command Discord would reject
+// fails the plan.
+func (r *DiscordApplicationCommandResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DiscordApplicationCommandModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateApplicationCommand(path.Empty(), data.command(), data.ServerID)...)
+}
+
+func (r *DiscordApplicationCommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordApplicationCommandModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params, diags := buildApplicationCommandParams(ctx, data.command())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	command, err := utils.ApplicationCommandCreateComplex(r.client.Session, r.client.ApplicationID, data.ServerID.ValueString(), params, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create application command", err.Error())
+		return
+	}
+
+	r.setModel(ctx, &data, command, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscordApplicationCommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordApplicationCommandModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	command, err := utils.GetApplicationCommand(r.client.Session, r.client.ApplicationID, data.ServerID.ValueString(), data.ID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "application command", data.ID.ValueString())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get application command %s", data.ID.ValueString()), err.Error())
+		return
+	}
+
+	r.setModel(ctx, &data, command, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscordApplicationCommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DiscordApplicationCommandModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params, diags := buildApplicationCommandParams(ctx, data.command())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	command, err := utils.ApplicationCommandEditComplex(r.client.Session, r.client.ApplicationID, data.ServerID.ValueString(), data.ID.ValueString(), params, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update application command %s", data.ID.ValueString()), err.Error())
+		return
+	}
+
+	r.setModel(ctx, &data, command, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscordApplicationCommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordApplicationCommandModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.Session.ApplicationCommandDelete(r.client.ApplicationID, data.ServerID.ValueString(), data.ID.ValueString(), discordgo.WithContext(ctx)); err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete application command %s", data.ID.ValueString()), err.Error())
+		return
+	}
+}
+
+// ImportState imports global commands by their ID, and the commands of a server as "server_id:command_id".
+func (r *DiscordApplicationCommandResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	commandID := req.ID
+	if serverID, id, ok := strings.Cut(req.ID, ":"); ok {
+		if serverID == "" || id == "" {
+			resp.Diagnostics.AddError("error importing Discord Application Command", "invalid ID specified. Please specify the ID as \"command_id\" or \"server_id:command_id\"")
+			return
+		}
+		commandID = id
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), commandID)...)
+}
+
+// command returns the command without its scope, for the functions shared with discord_application_commands.
+func (m *DiscordApplicationCommandModel) command() DiscordApplicationCommandsCommandModel {
+	return DiscordApplicationCommandsCommandModel{
+		ID:                       m.ID,
+		Type:                     m.Type,
+		Name:                     m.Name,
+		NameLocalizations:        m.NameLocalizations,
+		Description:              m.Description,
+		DescriptionLocalizations: m.DescriptionLocalizations,
+		DefaultMemberPermissions: m.DefaultMemberPermissions,
+		NSFW:                     m.NSFW,
+		IntegrationTypes:         m.IntegrationTypes,
+		Contexts:                 m.Contexts,
+		Option:                   m.Option,
+		Subcommand:               m.Subcommand,
+		SubcommandGroup:          m.SubcommandGroup,
+	}
+}
+
+// setModel sets the model to a command returned by Discord.
+func (r *DiscordApplicationCommandResource) setModel(ctx context.Context, data *DiscordApplicationCommandModel, command *utils.ApplicationCommand, diags *diag.Diagnostics) {
+	model, d := buildApplicationCommandModel(ctx, command)
+	diags.Append(d...)
+
+	data.ID = model.ID
+	data.ApplicationID = types.StringValue(command.ApplicationID)
+	data.ServerID = utils.StringValueOrNull(command.GuildID)
+	data.Type = model.Type
+	data.Name = model.Name
+	data.NameLocalizations = model.NameLocalizations
+	data.Description = model.Description
+	data.DescriptionLocalizations = model.DescriptionLocalizations
+	data.DefaultMemberPermissions = model.DefaultMemberPermissions
+	data.NSFW = model.NSFW
+	data.IntegrationTypes = model.IntegrationTypes
+	data.Contexts = model.Contexts
+	data.Option = model.Option
+	data.Subcommand = model.Subcommand
+	data.SubcommandGroup = model.SubcommandGroup
+}
+
+// validateApplicationCommand checks a command at p. Contexts and integration types can only be set on global
+// commands.
+func validateApplicationCommand(p path.Path, data DiscordApplicationCommandsCommandModel, serverID types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !serverID.IsNull() {
+		if !data.Contexts.IsNull() && !data.Contexts.IsUnknown() {
+			diags.AddAttributeError(p.AtName("contexts"), "Invalid application command", "contexts can only be set on global commands.")
+		}
+		if !data.IntegrationTypes.IsNull() && !data.IntegrationTypes.IsUnknown() {
+			diags.AddAttributeError(p.AtName("integration_types"), "Invalid application command", "integration_types can only be set on global commands.")
+		}
+	}
+	if data.Type.IsUnknown() {
+		return diags
+	}
+
+	// The default type is not part of the configuration.
+	commandType := data.Type.ValueString()
+	if data.Type.IsNull() {
+		commandType = "chat_input"
+	}
+	if commandType != "chat_input" {
+		if !data.Description.IsNull() || !data.DescriptionLocalizations.IsNull() {
+			diags.AddAttributeError(p.AtName("description"), "Invalid application command", fmt.Sprintf("%s commands cannot have a description.", commandType))
+		}
+		if len(data.Option) > 0 || len(data.Subcommand) > 0 || len(data.SubcommandGroup) > 0 {
+			diags.AddAttributeError(p.AtName("option"), "Invalid application command", fmt.Sprintf("%s commands cannot have options or subcommands.", commandType))
+		}
+		return diags
+	}
+
+	if !data.Name.IsUnknown() && !chatInputNameRegex.MatchString(data.Name.ValueString()) {
+		diags.AddAttributeError(p.AtName("name"), "Invalid application command", "Names of chat_input commands must be lowercase letters, numbers, dashes and underscores.")
+	}
+	if data.Description.IsNull() {
+		diags.AddAttributeError(p.AtName("description"), "Missing application command description", "description is required for chat_input commands.")
+	}
+	subcommands := len(data.Subcommand) + len(data.SubcommandGroup)
+	if len(data.Option) > 0 && subcommands > 0 {
+		diags.AddAttributeError(p.AtName("option"), "Invalid application command", "Commands with subcommands or subcommand groups cannot have options.")
+	}
+	if subcommands > applicationCommandMaxOptions {
+		diags.AddAttributeError(p.AtName("subcommand"), "Invalid application command", fmt.Sprintf("Commands can have at most %d subcommands and subcommand groups, got %d.", applicationCommandMaxOptions, subcommands))
+	}
+
+	names := map[string]bool{}
+	uniqueName := func(namePath path.Path, name types.String) {
+		if name.IsUnknown() {
+			return
+		}
+		if names[name.ValueString()] {
+			diags.AddAttributeError(namePath, "Duplicate option name", fmt.Sprintf("%q is used more than once.", name.ValueString()))
+		}
+		names[name.ValueString()] = true
+	}
+	diags.Append(validateApplicationCommandOptions(p.AtName("option"), data.Option)...)
+	for i, subcommand := range data.Subcommand {
+		subcommandPath := p.AtName("subcommand").AtListIndex(i)
+		uniqueName(subcommandPath.AtName("name"), subcommand.Name)
+		diags.Append(validateApplicationCommandOptions(subcommandPath.AtName("option"), subcommand.Option)...)
+	}
+	for i, group := range data.SubcommandGroup {
+		groupPath := p.AtName("subcommand_group").AtListIndex(i)
+		uniqueName(groupPath.AtName("name"), group.Name)
+		groupNames := map[string]bool{}
+		for j, subcommand := range group.Subcommand {
+			subcommandPath := groupPath.AtName("subcommand").AtListIndex(j)
+			if !subcommand.Name.IsUnknown() && groupNames[subcommand.Name.ValueString()] {
+				diags.AddAttributeError(subcommandPath.AtName("name"), "Duplicate option name", fmt.Sprintf("%q is used more than once.", subcommand.Name.ValueString()))
+			}
+			groupNames[subcommand.Name.ValueString()] = true
+			diags.Append(validateApplicationCommandOptions(subcommandPath.AtName("option"), subcommand.Option)...)
+		}
+	}
+
+	return diags
+}
+
+// validateApplicationCommandOptions checks that the attributes of each option suit its type, and that required
+// options come first.
+func validateApplicationCommandOptions(p path.Path, options []DiscordApplicationCommandOptionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	names := map[string]bool{}
+	optional := false
+	for i, option := range options {
+		optionPath := p.AtListIndex(i)
+		if !option.Name.IsUnknown() {
+			if names[option.Name.ValueString()] {
+				diags.AddAttributeError(optionPath.AtName("name"), "Duplicate option name", fmt.Sprintf("%q is used more than once.", option.Name.ValueString()))
+			}
+			names[option.Name.ValueString()] = true
+		}
+		if !option.Required.IsUnknown() {
+			if option.Required.ValueBool() && optional {
+				diags.AddAttributeError(optionPath.AtName("required"), "Invalid option order", "Required options must come before the options that are not required.")
+			}
+			optional = optional || !option.Required.ValueBool()
+		}
+		if option.Type.IsUnknown() {
+			continue
+		}
+
+		optionType := option.Type.ValueString()
+		numeric := optionType == "integer" || optionType == "number"
+		if len(option.Choice) > 0 && !numeric && optionType != "string" {
+			diags.AddAttributeError(optionPath.AtName("choice"), "Invalid option", fmt.Sprintf("%s options cannot have choices.", optionType))
+		}
+		if option.Autocomplete.ValueBool() && (len(option.Choice) > 0 || !numeric && optionType != "string") {
+			diags.AddAttributeError(optionPath.AtName("autocomplete"), "Invalid option", "autocomplete is only for string, integer and number options without choices.")
+		}
+		if !numeric && (!option.MinValue.IsNull() || !option.MaxValue.IsNull()) {
+			diags.AddAttributeError(optionPath.AtName("min_value"), "Invalid option", "min_value and max_value are only for integer and number options.")
+		}
+		if optionType != "string" && (!option.MinLength.IsNull() || !option.MaxLength.IsNull()) {
+			diags.AddAttributeError(optionPath.AtName("min_length"), "Invalid option", "min_length and max_length are only for string options.")
+		}
+		if optionType != "channel" && !option.ChannelTypes.IsNull() {
+			diags.AddAttributeError(optionPath.AtName("channel_types"), "Invalid option", "channel_types is only for channel options.")
+		}
+		if optionType == "integer" {
+			for _, value := range []types.Float64{option.MinValue, option.MaxValue} {
+				if !value.IsNull() && !value.IsUnknown() && value.ValueFloat64() != math.Trunc(value.ValueFloat64()) {
+					diags.AddAttributeError(optionPath.AtName("min_value"), "Invalid option", "min_value and max_value of integer options must be whole numbers.")
+				}
+			}
+		}
+		if !option.MinValue.IsNull() && !option.MaxValue.IsNull() && option.MinValue.ValueFloat64() > option.MaxValue.ValueFloat64() {
+			diags.AddAttributeError(optionPath.AtName("max_value"), "Invalid option", "max_value cannot be smaller than min_value.")
+		}
+		if !option.MinLength.IsNull() && !option.MaxLength.IsNull() && option.MinLength.ValueInt64() > option.MaxLength.ValueInt64() {
+			diags.AddAttributeError(optionPath.AtName("max_length"), "Invalid option", "max_length cannot be smaller than min_length.")
+		}
+		for j, choice := range option.Choice {
+			if choice.Value.IsUnknown() || !numeric {
+				continue
+			}
+			if _, ok := applicationCommandChoiceValue(optionType, choice.Value.ValueString()); !ok {
+				diags.AddAttributeError(optionPath.AtName("choice").AtListIndex(j).AtName("value"), "Invalid choice value", fmt.Sprintf("Values of %s options must be written as plain numbers, such as \"10\" or \"2.5\", got %q.", optionType, choice.Value.ValueString()))
+			}
+		}
+	}
+
+	return diags
+}
+
+// applicationCommandChoiceValue returns the value of a choice as Discord takes it. Numbers must be written the way
+// Discord returns them, so that they are read back unchanged.
+func applicationCommandChoiceValue(optionType string, value string) (any, bool) {
+	switch optionType {
+	case "integer":
+		number, err := strconv.ParseInt(value, 10, 64)
+		return number, err == nil && strconv.FormatInt(number, 10) == value
+	case "number":
+		number, err := strconv.ParseFloat(value, 64)
+		return number, err == nil && strconv.FormatFloat(number, 'f', -1, 64) == value
+	}
+
+	return value, true
+}
+
+func buildApplicationCommandParams(ctx context.Context, data DiscordApplicationCommandsCommandModel) (*utils.ApplicationCommand, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	params := &utils.ApplicationCommand{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		NSFW:        data.NSFW.ValueBool(),
+	}
+	for commandType, name := range applicationCommandTypes {
+		if name == data.Type.ValueString() {
+			params.Type = commandType
+		}
+	}
+	params.NameLocalizations = buildApplicationCommandLocalizations(ctx, data.NameLocalizations, &diags)
+	params.DescriptionLocalizations = buildApplicationCommandLocalizations(ctx, data.DescriptionLocalizations, &diags)
+	if !data.DefaultMemberPermissions.IsNull() {
+		permissions := strconv.FormatInt(data.DefaultMemberPermissions.ValueInt64(), 10)
+		params.DefaultMemberPermissions = &permissions
+	}
+
+	// Unknown integration types and contexts are left to Discord's defaults.
+	var integrationTypes, contexts []string
+	if !data.IntegrationTypes.IsUnknown() {
+		diags.Append(data.IntegrationTypes.ElementsAs(ctx, &integrationTypes, false)...)
+	}
+	if !data.Contexts.IsUnknown() {
+		diags.Append(data.Contexts.ElementsAs(ctx, &contexts, false)...)
+	}
+	for value, name := range applicationIntegrationTypes {
+		if utils.Contains(integrationTypes, name) {
+			params.IntegrationTypes = append(params.IntegrationTypes, value)
+		}
+	}
+	sort.Slice(params.IntegrationTypes, func(i, j int) bool { return params.IntegrationTypes[i] < params.IntegrationTypes[j] })
+	for value, name := range interactionContextTypes {
+		if utils.Contains(contexts, name) {
+			params.Contexts = append(params.Contexts, value)
+		}
+	}
+	sort.Slice(params.Contexts, func(i, j int) bool { return params.Contexts[i] < params.Contexts[j] })
+
+	params.Options = buildApplicationCommandOptions(ctx, data.Option, &diags)
+	for _, subcommand := range data.Subcommand {
+		params.Options = append(params.Options, buildApplicationCommandSubcommand(ctx, subcommand, &diags))
+	}
+	for _, group := range data.SubcommandGroup {
+		option := utils.ApplicationCommandOption{
+			Type:                     discordgo.ApplicationCommandOptionSubCommandGroup,
+			Name:                     group.Name.ValueString(),
+			NameLocalizations:        buildApplicationCommandLocalizations(ctx, group.NameLocalizations, &diags),
+			Description:              group.Description.ValueString(),
+			DescriptionLocalizations: buildApplicationCommandLocalizations(ctx, group.DescriptionLocalizations, &diags),
+		}
+		for _, subcommand := range group.Subcommand {
+			option.Options = append(option.Options, buildApplicationCommandSubcommand(ctx, subcommand, &diags))
+		}
+		params.Options = append(params.Options, option)
+	}
+	// Chat input commands always send their options, so that the last one can be removed.
+	if params.Options == nil && params.Type != discordgo.UserApplicationCommand && params.Type != discordgo.MessageApplicationCommand {
+		params.Options = []utils.ApplicationCommandOption{}
+	}
+
+	return params, diags
+}
+
+func buildApplicationCommandSubcommand(ctx context.Context, subcommand DiscordApplicationCommandSubcommandModel, diags *diag.Diagnostics) utils.ApplicationCommandOption {
+	return utils.ApplicationCommandOption{
+		Type:                     discordgo.ApplicationCommandOptionSubCommand,
+		Name:                     subcommand.Name.ValueString(),
+		NameLocalizations:        buildApplicationCommandLocalizations(ctx, subcommand.NameLocalizations, diags),
+		Description:              subcommand.Description.ValueString(),
+		DescriptionLocalizations: buildApplicationCommandLocalizations(ctx, subcommand.DescriptionLocalizations, diags),
+		Options:                  buildApplicationCommandOptions(ctx, subcommand.Option, diags),
+	}
+}
+
+func buildApplicationCommandOptions(ctx context.Context, options []DiscordApplicationCommandOptionModel, diags *diag.Diagnostics) []utils.ApplicationCommandOption {
+	var params []utils.ApplicationCommandOption
+	for _, option := range options {
+		param := utils.ApplicationCommandOption{
+			Name:                     option.Name.ValueString(),
+			NameLocalizations:        buildApplicationCommandLocalizations(ctx, option.NameLocalizations, diags),
+			Description:              option.Description.ValueString(),
+			DescriptionLocalizations: buildApplicationCommandLocalizations(ctx, option.DescriptionLocalizations, diags),
+			Required:                 option.Required.ValueBool(),
+			Autocomplete:             option.Autocomplete.ValueBool(),
+			MinValue:                 option.MinValue.ValueFloat64Pointer(),
+			MaxValue:                 option.MaxValue.ValueFloat64Pointer(),
+		}
+		for optionType, name := range applicationCommandOptionTypes {
+			if name == option.Type.ValueString() {
+				param.Type = optionType
+			}
+		}
+		if !option.MinLength.IsNull() {
+			minLength := int(option.MinLength.ValueInt64())
+			param.MinLength = &minLength
+		}
+		if !option.MaxLength.IsNull() {
+			maxLength := int(option.MaxLength.ValueInt64())
+			param.MaxLength = &maxLength
+		}
+
+		var channelTypes []string
+		diags.Append(option.ChannelTypes.ElementsAs(ctx, &channelTypes, false)...)
+		for channelType, name := range applicationCommandChannelTypes {
+			if utils.Contains(channelTypes, name) {
+				param.ChannelTypes = append(param.ChannelTypes, channelType)
+			}
+		}
+		sort.Slice(param.ChannelTypes, func(i, j int) bool { return param.ChannelTypes[i] < param.ChannelTypes[j] })
+
+		for _, choice := range option.Choice {
+			value, _ := applicationCommandChoiceValue(option.Type.ValueString(), choice.Value.ValueString())
+			param.Choices = append(param.Choices, utils.ApplicationCommandOptionChoice{
+				Name:              choice.Name.ValueString(),
+				NameLocalizations: buildApplicationCommandLocalizations(ctx, choice.NameLocalizations, diags),
+				Value:             value,
+			})
+		}
+		params = append(params, param)
+	}
+
+	return params
+}
+
+func buildApplicationCommandLocalizations(ctx context.Context, localizations types.Map, diags *diag.Diagnostics) map[discordgo.Locale]string {
+	if localizations.IsNull() || localizations.IsUnknown() {
+		return nil
+	}
+	var values map[string]string
+	diags.Append(localizations.ElementsAs(ctx, &values, false)...)
+	locales := make(map[discordgo.Locale]string, len(values))
+	for locale, value := range values {
+		locales[discordgo.Locale(locale)] = value
+	}
+
+	return locales
+}
+
+// buildApplicationCommandModel returns a command returned by Discord as a model. Options are split into the option,
+// subcommand and subcommand_group blocks by their type.
+func buildApplicationCommandModel(ctx context.Context, command *utils.ApplicationCommand) (DiscordApplicationCommandsCommandModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	model := DiscordApplicationCommandsCommandModel{
+		ID:                       types.StringValue(command.ID),
+		Type:                     types.StringValue(applicationCommandTypes[command.Type]),
+		Name:                     types.StringValue(command.Name),
+		NameLocalizations:        buildApplicationCommandLocalizationsModel(ctx, command.NameLocalizations, &diags),
+		Description:              utils.StringValueOrNull(command.Description),
+		DescriptionLocalizations: buildApplicationCommandLocalizationsModel(ctx, command.DescriptionLocalizations, &diags),
+		DefaultMemberPermissions: types.Int64Null(),
+		NSFW:                     types.BoolValue(command.NSFW),
+	}
+	if command.DefaultMemberPermissions != nil {
+		permissions, err := strconv.ParseInt(*command.DefaultMemberPermissions, 10, 64)
+		if err != nil {
+			diags.AddError("Invalid default member permissions", err.Error())
+		}
+		model.DefaultMemberPermissions = types.Int64Value(permissions)
+	}
+
+	integrationTypes := make([]string, 0, len(command.IntegrationTypes))
+	for _, integrationType := range command.IntegrationTypes {
+		integrationTypes = append(integrationTypes, applicationIntegrationTypes[integrationType])
+	}
+	contexts := make([]string, 0, len(command.Contexts))
+	for _, contextType := range command.Contexts {
+		contexts = append(contexts, interactionContextTypes[contextType])
+	}
+	var d diag.Diagnostics
+	model.IntegrationTypes, d = utils.StringSetOrNull(ctx, integrationTypes, types.SetNull(types.StringType))
+	diags.Append(d...)
+	model.Contexts, d = utils.StringSetOrNull(ctx, contexts, types.SetNull(types.StringType))
+	diags.Append(d...)
+
+	var options []utils.ApplicationCommandOption
+	for _, option := range command.Options {
+		switch option.Type {
+		case discordgo.ApplicationCommandOptionSubCommand:
+			model.Subcommand = append(model.Subcommand, buildApplicationCommandSubcommandModel(ctx, option, &diags))
+		case discordgo.ApplicationCommandOptionSubCommandGroup:
+			group := DiscordApplicationCommandSubcommandGroupModel{
+				Name:                     types.StringValue(option.Name),
+				NameLocalizations:        buildApplicationCommandLocalizationsModel(ctx, option.NameLocalizations, &diags),
+				Description:              types.StringValue(option.Description),
+				DescriptionLocalizations: buildApplicationCommandLocalizationsModel(ctx, option.DescriptionLocalizations, &diags),
+			}
+			for _, subcommand := range option.Options {
+				group.Subcommand = append(group.Subcommand, buildApplicationCommandSubcommandModel(ctx, subcommand, &diags))
+			}
+			model.SubcommandGroup = append(model.SubcommandGroup, group)
+		default:
+			options = append(options, option)
+		}
+	}
+	model.Option = buildApplicationCommandOptionModels(ctx, options, &diags)
+
+	return model, diags
+}
+
+func buildApplicationCommandSubcommandModel(ctx context.Context, subcommand utils.ApplicationCommandOption, diags *diag.Diagnostics) DiscordApplicationCommandSubcommandModel {
+	return DiscordApplicationCommandSubcommandModel{
+		Name:                     types.StringValue(subcommand.Name),
+		NameLocalizations:        buildApplicationCommandLocalizationsModel(ctx, subcommand.NameLocalizations, diags),
+		Description:              types.StringValue(subcommand.Description),
+		DescriptionLocalizations: buildApplicationCommandLocalizationsModel(ctx, subcommand.DescriptionLocalizations, diags),
+		Option:                   buildApplicationCommandOptionModels(ctx, subcommand.Options, diags),
+	}
+}
+
+func buildApplicationCommandOptionModels(ctx context.Context, options []utils.ApplicationCommandOption, diags *diag.Diagnostics) []DiscordApplicationCommandOptionModel {
+	var models []DiscordApplicationCommandOptionModel
+	for _, option := range options {
+		optionType := applicationCommandOptionTypes[option.Type]
+		model := DiscordApplicationCommandOptionModel{
+			Type:                     types.StringValue(optionType),
+			Name:                     types.StringValue(option.Name),
+			NameLocalizations:        buildApplicationCommandLocalizationsModel(ctx, option.NameLocalizations, diags),
+			Description:              types.StringValue(option.Description),
+			DescriptionLocalizations: buildApplicationCommandLocalizationsModel(ctx, option.DescriptionLocalizations, diags),
+			Required:                 types.BoolValue(option.Required),
+			Autocomplete:             types.BoolValue(option.Autocomplete),
+			MinValue:                 types.Float64PointerValue(option.MinValue),
+			MaxValue:                 types.Float64PointerValue(option.MaxValue),
+			MinLength:                types.Int64Null(),
+			MaxLength:                types.Int64Null(),
+		}
+		if option.MinLength != nil {
+			model.MinLength = types.Int64Value(int64(*option.MinLength))
+		}
+		if option.MaxLength != nil {
+			model.MaxLength = types.Int64Value(int64(*option.MaxLength))
+		}
+
+		channelTypes := make([]string, 0, len(option.ChannelTypes))
+		for _, channelType := range option.ChannelTypes {
+			channelTypes = append(channelTypes, applicationCommandChannelTypes[channelType])
+		}
+		var d diag.Diagnostics
+		model.ChannelTypes, d = utils.StringSetOrNull(ctx, channelTypes, types.SetNull(types.StringType))
+		diags.Append(d...)
+
+		for _, choice := range option.Choices {
+			value := fmt.Sprint(choice.Value)
+			if number, ok := choice.Value.(float64); ok {
+				value = strconv.FormatFloat(number, 'f', -1, 64)
+			}
+			model.Choice = append(model.Choice, DiscordApplicationCommandChoiceModel{
+				Name:              types.StringValue(choice.Name),
+				NameLocalizations: buildApplicationCommandLocalizationsModel(ctx, choice.NameLocalizations, diags),
+				Value:             types.StringValue(value),
+			})
+		}
+		models = append(models, model)
+	}
+
+	return models
+}
+
+// buildApplicationCommandLocalizationsModel returns the localizations of a name or description, which are null when
+// there are none as the attributes cannot be empty.
+func buildApplicationCommandLocalizationsModel(ctx context.Context, localizations map[discordgo.Locale]string, diags *diag.Diagnostics) types.Map {
+	if len(localizations) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	values := make(map[string]string, len(localizations))
+	for locale, value := range localizations {
+		values[string(locale)] = value
+	}
+	value, d := types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+
+	return value
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceDiscordApplicationCommand(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_application_command.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "discord_application_command" "example" {
+				  server_id = "%s"
+				  name = "Report"
+				  option {
+				    type = "user"
+				    name = "user"
+				    description = "Who"
+				  }
+				  option {
+				    type = "integer"
+				    name = "days"
+				    description = "How long"
+				    required = true
+				    choice {
+				      name = "A week"
+				      value = "07"
+				    }
+				  }
+				}`, testServerID),
+				ExpectError: regexp.MustCompile(`(?s)description is required for chat_input commands.*Names of chat_input commands must be lowercase.*Required options must come before.*Values of integer options must be written as plain numbers`),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "discord_application_command" "example" {
+				  server_id = "%s"
+				  type = "user"
+				  name = "Report User"
+				  description = "Report a user"
+				  contexts = ["guild"]
+				}`, testServerID),
+				ExpectError: regexp.MustCompile(`(?s)user commands cannot have a description.*contexts can only be set on global commands`),
+			},
+			{
+				Config: testAccResourceDiscordApplicationCommand(testServerID, "Moderate members", 8),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttrSet(name, "application_id"),
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "type", "chat_input"),
+					resource.TestCheckResourceAttr(name, "name_localizations.de", "moderieren"),
+					resource.TestCheckResourceAttr(name, "default_member_permissions", "8"),
+					resource.TestCheckResourceAttr(name, "nsfw", "false"),
+					resource.TestCheckResourceAttr(name, "integration_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "integration_types.*", "guild_install"),
+					resource.TestCheckNoResourceAttr(name, "contexts"),
+					resource.TestCheckResourceAttr(name, "option.#", "0"),
+					resource.TestCheckResourceAttr(name, "subcommand.#", "1"),
+					resource.TestCheckResourceAttr(name, "subcommand.0.option.#", "2"),
+					resource.TestCheckResourceAttr(name, "subcommand.0.option.0.required", "true"),
+					resource.TestCheckResourceAttr(name, "subcommand.0.option.0.min_length", "1"),
+					resource.TestCheckResourceAttr(name, "subcommand.0.option.0.max_length", "512"),
+					resource.TestCheckResourceAttr(name, "subcommand.0.option.1.autocomplete", "true"),
+					resource.TestCheckResourceAttr(name, "subcommand_group.#", "1"),
+					resource.TestCheckResourceAttr(name, "subcommand_group.0.subcommand.0.option.0.type", "integer"),
+					resource.TestCheckResourceAttr(name, "subcommand_group.0.subcommand.0.option.0.min_value", "0"),
+					resource.TestCheckResourceAttr(name, "subcommand_group.0.subcommand.0.option.0.max_value", "40320"),
+					resource.TestCheckResourceAttr(name, "subcommand_group.0.subcommand.0.option.0.choice.#", "0"),
+					resource.TestCheckResourceAttr(name, "subcommand_group.0.subcommand.0.option.1.channel_types.#", "2"),
+					resource.TestCheckResourceAttr(name, "subcommand_group.0.subcommand.1.option.0.choice.1.value", "2.5"),
+					resource.TestCheckResourceAttr(name, "subcommand_group.0.subcommand.1.option.0.choice.1.name_localizations.fr", "deux et demi"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordApplicationCommand(testServerID, "Moderate the server", 0),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "description", "Moderate the server"),
+					resource.TestCheckResourceAttr(name, "default_member_permissions", "0"),
+				),
+			},
+			{
+				// Removing every subcommand and subcommand group leaves the command without options.
+				Config: fmt.Sprintf(`
+				resource "discord_application_command" "example" {
+				  server_id = "%s"
+				  name = "moderate"
+				  description = "Moderate the server"
+				}`, testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "option.#", "0"),
+					resource.TestCheckResourceAttr(name, "subcommand.#", "0"),
+					resource.TestCheckResourceAttr(name, "subcommand_group.#", "0"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccApplicationCommandImportID(name),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccResourceDiscordApplicationCommand has a subcommand and a subcommand group, with options of most types.
+func testAccResourceDiscordApplicationCommand(serverID string, description string, permissions int) string {
+	return fmt.Sprintf(`
+	resource "discord_application_command" "example" {
+	  server_id = "%s"
+	  name = "moderate"
+	  name_localizations = {
+	    de = "moderieren"
+	  }
+	  description = "%s"
+	  default_member_permissions = %d
+
+	  subcommand {
+	    name = "warn"
+	    description = "Warn a member"
+	    option {
+	      type = "string"
+	      name = "reason"
+	      description = "Why the member is warned"
+	      required = true
+	      min_length = 1
+	      max_length = 512
+	    }
+	    option {
+	      type = "string"
+	      name = "rule"
+	      description = "The rule that was broken"
+	      autocomplete = true
+	    }
+	  }
+
+	  subcommand_group {
+	    name = "timeout"
+	    description = "Time members out"
+	    subcommand {
+	      name = "add"
+	      description = "Time a member out"
+	      option {
+	        type = "integer"
+	        name = "minutes"
+	        description = "How long"
+	        min_value = 0
+	        max_value = 40320
+	      }
+	      option {
+	        type = "channel"
+	        name = "log"
+	        description = "Where to log it"
+	        channel_types = ["text", "public_thread"]
+	      }
+	    }
+	    subcommand {
+	      name = "scale"
+	      description = "Scale the timeouts"
+	      option {
+	        type = "number"
+	        name = "factor"
+	        description = "By how much"
+	        choice {
+	          name = "Double"
+	          value = "2"
+	        }
+	        choice {
+	          name = "Two and a half"
+	          name_localizations = {
+	            fr = "deux et demi"
+	          }
+	          value = "2.5"
+	        }
+	      }
+	    }
+	  }
+	}`, serverID, description, permissions)
+}
+
+func testAccApplicationCommandImportID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource not found in state: %s", name)
+		}
+
+		return rs.Primary.Attributes["server_id"] + ":" + rs.Primary.ID, nil
+	}
+}
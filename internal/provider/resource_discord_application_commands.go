@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// applicationCommandLimits are the most commands of each type Discord allows in a scope.
+var applicationCommandLimits = map[string]int{
+	"chat_input": 100,
+	"user":       15,
+	"message":    15,
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordApplicationCommandsResource{}
+var _ resource.ResourceWithImportState = &DiscordApplicationCommandsResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordApplicationCommandsResource{}
+var _ resource.ResourceWithValidateConfig = &DiscordApplicationCommandsResource{}
+
+func NewDiscordApplicationCommandsResource() resource.Resource {
+	return &DiscordApplicationCommandsResource{}
+}
+
+type DiscordApplicationCommandsResource struct {
+	client *Context
+}
+
+type DiscordApplicationCommandsModel struct {
+	ApplicationID types.String                             `tfsdk:"application_id"`
+	ServerID      types.String                             `tfsdk:"server_id"`
+	Command       []DiscordApplicationCommandsCommandModel `tfsdk:"command"`
+}
+
+func (r *DiscordApplicationCommandsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_commands"
+}
+
+func (r *DiscordApplicationCommandsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Application Commands Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "The ID of the application the commands belong to",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Description: "The ID of the server the commands are registered in. The global commands are managed without one. The provider `default_server_id` is not used. Changing it replaces the commands",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"command": schema.ListNestedBlock{
+				Description: "A command. Commands that are not listed are deleted",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: applicationCommandAttributes(true),
+					Blocks:     applicationCommandBlocks(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DiscordApplicationCommandsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks each command, that no two commands have the same name and type, and the number of commands
+// of each type.
+func (r *DiscordApplicationCommandsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DiscordApplicationCommandsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	counts := map[string]int{}
+	names := map[string]bool{}
+	for i, command := range data.Command {
+		commandPath := path.Root("command").AtListIndex(i)
+		resp.Diagnostics.Append(validateApplicationCommand(commandPath, command, data.ServerID)...)
+		if command.Type.IsUnknown() || command.Name.IsUnknown() {
+			continue
+		}
+		// Defaults are not applied to the config yet, so a null type is a chat input command.
+		commandType := command.Type.ValueString()
+		if command.Type.IsNull() {
+			commandType = "chat_input"
+		}
+		counts[commandType]++
+		key := commandType + "/" + command.Name.ValueString()
+		if names[key] {
+			resp.Diagnostics.AddAttributeError(commandPath.AtName("name"), "Duplicate application command", fmt.Sprintf("There is more than one %s command named %q.", commandType, command.Name.ValueString()))
+		}
+		names[key] = true
+	}
+	for commandType, limit := range applicationCommandLimits {
+		if counts[commandType] > limit {
+			resp.Diagnostics.AddAttributeError(path.Root("command"), "Too many application commands", fmt.Sprintf("Discord allows at most %d %s commands, got %d.", limit, commandType, counts[commandType]))
+		}
+	}
+}
+
+// ModifyPlan plans the IDs of commands that already exist. Discord keeps the ID of a command when a command of the
+// same name and type is overwritten, whatever its position in the list.
+func (r *DiscordApplicationCommandsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state DiscordApplicationCommandsModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, command := range plan.Command {
+		existing := findApplicationCommand(state.Command, command)
+		if existing == nil {
+			continue
+		}
+		commandPath := path.Root("command").AtListIndex(i)
+		if command.ID.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, commandPath.AtName("id"), existing.ID)...)
+		}
+		if command.IntegrationTypes.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, commandPath.AtName("integration_types"), existing.IntegrationTypes)...)
+		}
+		if command.Contexts.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, commandPath.AtName("contexts"), existing.Contexts)...)
+		}
+	}
+}
+
+func (r *DiscordApplicationCommandsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordApplicationCommandsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.overwrite(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read lists the commands in the order of the state, followed by the commands that were created elsewhere.
+func (r *DiscordApplicationCommandsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordApplicationCommandsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	commands, err := utils.GetApplicationCommands(r.client.Session, r.client.ApplicationID, serverID, discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) && serverID != "" {
+		utils.RemoveNotFound(ctx, resp, "server", serverID)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get application commands", err.Error())
+		return
+	}
+
+	read := make([]bool, len(commands))
+	var models []DiscordApplicationCommandsCommandModel
+	for _, command := range data.Command {
+		for i := range commands {
+			if !read[i] && commands[i].ID == command.ID.ValueString() {
+				read[i] = true
+				model, diags := buildApplicationCommandModel(ctx, &commands[i])
+				resp.Diagnostics.Append(diags...)
+				models = append(models, model)
+			}
+		}
+	}
+	for i := range commands {
+		if !read[i] {
+			model, diags := buildApplicationCommandModel(ctx, &commands[i])
+			resp.Diagnostics.Append(diags...)
+			models = append(models, model)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ApplicationID = types.StringValue(r.client.ApplicationID)
+	data.Command = models
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscordApplicationCommandsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DiscordApplicationCommandsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.overwrite(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes every command of the scope.
+func (r *DiscordApplicationCommandsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordApplicationCommandsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	if _, err := utils.ApplicationCommandBulkOverwriteComplex(r.client.Session, r.client.ApplicationID, serverID, nil, discordgo.WithContext(ctx)); err != nil && !(utils.IsNotFound(err) && serverID != "") {
+		resp.Diagnostics.AddError("Failed to delete application commands", err.Error())
+		return
+	}
+}
+
+// ImportState imports the commands of a server by its ID, and the global commands with the ID "global".
+func (r *DiscordApplicationCommandsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError("error importing Discord Application Commands", "invalid ID specified. Please specify the ID as \"server_id\" or \"global\"")
+		return
+	}
+	if req.ID != "global" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), req.ID)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), r.client.ApplicationID)...)
+}
+
+// overwrite replaces the commands of the scope with the planned commands, and sets the model to the commands
+// Discord returns.
+func (r *DiscordApplicationCommandsResource) overwrite(ctx context.Context, data *DiscordApplicationCommandsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	params := make([]utils.ApplicationCommand, 0, len(data.Command))
+	for _, command := range data.Command {
+		param, d := buildApplicationCommandParams(ctx, command)
+		diags.Append(d...)
+		params = append(params, *param)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	commands, err := utils.ApplicationCommandBulkOverwriteComplex(r.client.Session, r.client.ApplicationID, data.ServerID.ValueString(), params, discordgo.WithContext(ctx))
+	if err != nil {
+		diags.AddError("Failed to overwrite application commands", err.Error())
+		return diags
+	}
+
+	models := make([]DiscordApplicationCommandsCommandModel, 0, len(commands))
+	for i := range commands {
+		model, d := buildApplicationCommandModel(ctx, &commands[i])
+		diags.Append(d...)
+		models = append(models, model)
+	}
+	data.ApplicationID = types.StringValue(r.client.ApplicationID)
+	data.Command = data.Command[:0]
+	for _, planned := range params {
+		for _, model := range models {
+			if model.Name.ValueString() == planned.Name && model.Type.ValueString() == applicationCommandTypes[planned.Type] {
+				data.Command = append(data.Command, model)
+			}
+		}
+	}
+
+	return diags
+}
+
+// findApplicationCommand returns the command in commands with the name and type of command.
+func findApplicationCommand(commands []DiscordApplicationCommandsCommandModel, command DiscordApplicationCommandsCommandModel) *DiscordApplicationCommandsCommandModel {
+	for i := range commands {
+		if commands[i].Name.Equal(command.Name) && commands[i].Type.Equal(command.Type) {
+			return &commands[i]
+		}
+	}
+
+	return nil
+}
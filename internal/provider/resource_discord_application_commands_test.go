@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceDiscordApplicationCommands(t *testing.T) {
+	name := "discord_application_commands.example"
+	var pingID string
+	// The resource owns every global command, so it cannot run in parallel with other tests of global commands.
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			client := testAccClient(t)
+			if err := client.Identify(context.Background()); err != nil {
+				return err
+			}
+			commands, err := utils.GetApplicationCommands(client.Session, client.ApplicationID, "")
+			if err != nil {
+				return err
+			}
+			if len(commands) != 0 {
+				return fmt.Errorf("expected no global commands, got %d", len(commands))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "discord_application_commands" "example" {
+				  command {
+				    name = "ping"
+				    description = "Ping the bot"
+				  }
+				  command {
+				    name = "ping"
+				    description = "Ping the bot again"
+				  }
+				}`,
+				ExpectError: regexp.MustCompile(`There is more than one chat_input command named "ping"`),
+			},
+			{
+				Config: testAccResourceDiscordApplicationCommands(`
+				  command {
+				    type = "message"
+				    name = "Quote"
+				  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "application_id"),
+					resource.TestCheckNoResourceAttr(name, "server_id"),
+					resource.TestCheckResourceAttr(name, "command.#", "3"),
+					resource.TestCheckResourceAttr(name, "command.0.name", "ping"),
+					resource.TestCheckResourceAttr(name, "command.0.contexts.#", "3"),
+					resource.TestCheckResourceAttr(name, "command.1.type", "user"),
+					resource.TestCheckNoResourceAttr(name, "command.1.description"),
+					resource.TestCheckResourceAttr(name, "command.2.type", "message"),
+					func(s *terraform.State) error {
+						pingID = s.RootModule().Resources[name].Primary.Attributes["command.0.id"]
+						return nil
+					},
+				),
+			},
+			{
+				// Commands created outside Terraform are deleted, and the ping command keeps its ID.
+				PreConfig: func() {
+					client := testAccClient(t)
+					if err := client.Identify(context.Background()); err != nil {
+						t.Fatal(err)
+					}
+					if _, err := utils.ApplicationCommandCreateComplex(client.Session, client.ApplicationID, "", &utils.ApplicationCommand{Name: "stray", Description: "Not managed by Terraform"}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccResourceDiscordApplicationCommands(`
+				  command {
+				    name = "echo"
+				    description = "Repeat a message"
+				    contexts = ["guild", "bot_dm"]
+				    integration_types = ["guild_install", "user_install"]
+				    option {
+				      type = "string"
+				      name = "message"
+				      description = "What to repeat"
+				      required = true
+				    }
+				  }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "command.#", "3"),
+					resource.TestCheckResourceAttrPtr(name, "command.0.id", &pingID),
+					resource.TestCheckResourceAttr(name, "command.2.name", "echo"),
+					resource.TestCheckResourceAttr(name, "command.2.contexts.#", "2"),
+					resource.TestCheckResourceAttr(name, "command.2.integration_types.#", "2"),
+					resource.TestCheckResourceAttr(name, "command.2.option.0.required", "true"),
+				),
+			},
+			{
+				ResourceName:                         name,
+				ImportState:                          true,
+				ImportStateId:                        "global",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "application_id",
+			},
+		},
+	})
+}
+
+// testAccResourceDiscordApplicationCommands has a chat input command and a user command, followed by commands.
+func testAccResourceDiscordApplicationCommands(commands string) string {
+	return fmt.Sprintf(`
+	resource "discord_application_commands" "example" {
+	  command {
+	    name = "ping"
+	    description = "Ping the bot"
+	  }
+	  command {
+	    type = "user"
+	    name = "Report User"
+	    default_member_permissions = 0
+	  }
+	  %s
+	}`, commands)
+}
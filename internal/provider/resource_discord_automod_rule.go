@@ -0,0 +1,574 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strings"
+)
+
+// autoModTriggerTypes are the values of the trigger_type attribute.
+var autoModTriggerTypes = map[discordgo.AutoModerationRuleTriggerType]string{
+	discordgo.AutoModerationEventTriggerKeyword:       "keyword",
+	discordgo.AutoModerationEventTriggerSpam:          "spam",
+	discordgo.AutoModerationEventTriggerKeywordPreset: "keyword_preset",
+	utils.AutoModerationEventTriggerMentionSpam:       "mention_spam",
+	utils.AutoModerationEventTriggerMemberProfile:     "member_profile",
+}
+
+// autoModActionTypes are the values of the action type attribute.
+var autoModActionTypes = map[discordgo.AutoModerationActionType]string{
+	discordgo.AutoModerationRuleActionBlockMessage:       "block_message",
+	discordgo.AutoModerationRuleActionSendAlertMessage:   "send_alert_message",
+	discordgo.AutoModerationRuleActionTimeout:            "timeout",
+	utils.AutoModerationRuleActionBlockMemberInteraction: "block_member_interaction",
+}
+
+// autoModPresets are the values of the presets attribute.
+var autoModPresets = map[discordgo.AutoModerationKeywordPreset]string{
+	discordgo.AutoModerationKeywordPresetProfanity:     "profanity",
+	discordgo.AutoModerationKeywordPresetSexualContent: "sexual_content",
+	discordgo.AutoModerationKeywordPresetSlurs:         "slurs",
+}
+
+// autoModMetadataLimits are the trigger metadata attributes each trigger type accepts, with the most entries Discord
+// allows in each list. Limits of 0 are for attributes that are not lists.
+var autoModMetadataLimits = map[string]map[string]int{
+	"keyword":        {"keyword_filter": 1000, "regex_patterns": 10, "allow_list": 100},
+	"spam":           {},
+	"keyword_preset": {"presets": 3, "allow_list": 1000},
+	"mention_spam":   {"mention_total_limit": 0, "mention_raid_protection_enabled": 0},
+	"member_profile": {"keyword_filter": 1000, "regex_patterns": 10, "allow_list": 100},
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordAutoModRuleResource{}
+var _ resource.ResourceWithImportState = &DiscordAutoModRuleResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordAutoModRuleResource{}
+var _ resource.ResourceWithValidateConfig = &DiscordAutoModRuleResource{}
+
+func NewDiscordAutoModRuleResource() resource.Resource {
+	return &DiscordAutoModRuleResource{}
+}
+
+type DiscordAutoModRuleResource struct {
+	client *Context
+}
+
+type DiscordAutoModRuleModel struct {
+	ID                           types.String                    `tfsdk:"id"`
+	ServerID                     types.String                    `tfsdk:"server_id"`
+	Name                         types.String                    `tfsdk:"name"`
+	TriggerType                  types.String                    `tfsdk:"trigger_type"`
+	KeywordFilter                types.Set                       `tfsdk:"keyword_filter"`
+	RegexPatterns                types.Set                       `tfsdk:"regex_patterns"`
+	Presets                      types.Set                       `tfsdk:"presets"`
+	AllowList                    types.Set                       `tfsdk:"allow_list"`
+	MentionTotalLimit            types.Int64                     `tfsdk:"mention_total_limit"`
+	MentionRaidProtectionEnabled types.Bool                      `tfsdk:"mention_raid_protection_enabled"`
+	Action                       []DiscordAutoModRuleActionModel `tfsdk:"action"`
+	ExemptRoles                  types.Set                       `tfsdk:"exempt_roles"`
+	ExemptChannels               types.Set                       `tfsdk:"exempt_channels"`
+	Enabled                      types.Bool                      `tfsdk:"enabled"`
+	AuditLogReason               types.String                    `tfsdk:"audit_log_reason"`
+}
+
+type DiscordAutoModRuleActionModel struct {
+	Type            types.String `tfsdk:"type"`
+	CustomMessage   types.String `tfsdk:"custom_message"`
+	ChannelID       types.String `tfsdk:"channel_id"`
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+}
+
+func (r *DiscordAutoModRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automod_rule"
+}
+
+func (r *DiscordAutoModRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord AutoMod Rule Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the rule",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": utils.ServerIDAttribute(),
+			"name": schema.StringAttribute{
+				Description: "The name of the rule",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"trigger_type": schema.StringAttribute{
+				Description: "What the rule checks. One of `keyword`, `spam`, `keyword_preset`, `mention_spam` and `member_profile`. Changing it replaces the rule",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("keyword", "spam", "keyword_preset", "mention_spam", "member_profile"),
+				},
+			},
+			"keyword_filter": schema.SetAttribute{
+				Description: "The words that trigger the rule, with `*` wildcards. Only for `keyword` and `member_profile` rules, at most 1000",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 60)),
+				},
+			},
+			"regex_patterns": schema.SetAttribute{
+				Description: "The Rust regular expressions that trigger the rule. Only for `keyword` and `member_profile` rules, at most 10",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 260)),
+				},
+			},
+			"presets": schema.SetAttribute{
+				Description: "The Discord word lists that trigger the rule. One or more of `profanity`, `sexual_content` and `slurs`. Only for `keyword_preset` rules",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("profanity", "sexual_content", "slurs")),
+				},
+			},
+			"allow_list": schema.SetAttribute{
+				Description: "The words that never trigger the rule. Only for `keyword`, `keyword_preset` and `member_profile` rules, at most 100, or 1000 for `keyword_preset` rules",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 60)),
+				},
+			},
+			"mention_total_limit": schema.Int64Attribute{
+				Description: "The number of unique user and role mentions allowed in a message. Required for `mention_spam` rules",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"mention_raid_protection_enabled": schema.BoolAttribute{
+				Description: "Whether to detect mention raids. Only for `mention_spam` rules",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"exempt_roles": schema.SetAttribute{
+				Description: "The IDs of the roles the rule does not apply to, at most 20",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(20),
+				},
+			},
+			"exempt_channels": schema.SetAttribute{
+				Description: "The IDs of the channels the rule does not apply to, at most 50",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(50),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the rule is enabled",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"action": schema.ListNestedBlock{
+				Description: "What Discord does when the rule is triggered. At least one is required",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "One of `block_message`, `send_alert_message`, `timeout` and `block_member_interaction`. `timeout` is only for `keyword` and `mention_spam` rules, and `block_member_interaction` only for `member_profile` rules",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("block_message", "send_alert_message", "timeout", "block_member_interaction"),
+							},
+						},
+						"custom_message": schema.StringAttribute{
+							Description: "The message shown to the member whose message is blocked. Only for `block_message` actions",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 150),
+							},
+						},
+						"channel_id": schema.StringAttribute{
+							Description: "The ID of the channel the alert is sent to. Required for `send_alert_message` actions",
+							Optional:    true,
+						},
+						"duration_seconds": schema.Int64Attribute{
+							Description: "How long the member is timed out for, at most 4 weeks. Required for `timeout` actions",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 2419200),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DiscordAutoModRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the trigger metadata and actions against what Discord allows for the trigger type, so that
+// a rule Discord would reject fails the plan.
+func (r *DiscordAutoModRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DiscordAutoModRuleModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.TriggerType.IsUnknown() {
+		return
+	}
+	trigger := data.TriggerType.ValueString()
+	limits := autoModMetadataLimits[trigger]
+
+	sets := map[string]types.Set{
+		"keyword_filter": data.KeywordFilter,
+		"regex_patterns": data.RegexPatterns,
+		"presets":        data.Presets,
+		"allow_list":     data.AllowList,
+	}
+	for name, set := range sets {
+		if set.IsNull() || set.IsUnknown() {
+			continue
+		}
+		limit, ok := limits[name]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid trigger metadata", fmt.Sprintf("%s cannot be used with %s rules.", name, trigger))
+			continue
+		}
+		if count := len(set.Elements()); count > limit {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid trigger metadata", fmt.Sprintf("%s rules allow at most %d %s entries, got %d.", trigger, limit, name, count))
+		}
+	}
+	if _, ok := limits["mention_total_limit"]; ok {
+		if data.MentionTotalLimit.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("mention_total_limit"), "Missing trigger metadata", "mention_total_limit is required for mention_spam rules.")
+		}
+	} else {
+		if !data.MentionTotalLimit.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("mention_total_limit"), "Invalid trigger metadata", fmt.Sprintf("mention_total_limit cannot be used with %s rules.", trigger))
+		}
+		if !data.MentionRaidProtectionEnabled.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("mention_raid_protection_enabled"), "Invalid trigger metadata", fmt.Sprintf("mention_raid_protection_enabled cannot be used with %s rules.", trigger))
+		}
+	}
+	switch trigger {
+	case "keyword", "member_profile":
+		if data.KeywordFilter.IsNull() && data.RegexPatterns.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("keyword_filter"), "Missing trigger metadata", fmt.Sprintf("%s rules need keyword_filter or regex_patterns.", trigger))
+		}
+	case "keyword_preset":
+		if data.Presets.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("presets"), "Missing trigger metadata", "presets is required for keyword_preset rules.")
+		}
+	}
+
+	for i, action := range data.Action {
+		actionPath := path.Root("action").AtListIndex(i)
+		if action.Type.IsUnknown() {
+			continue
+		}
+		actionType := action.Type.ValueString()
+		if !action.CustomMessage.IsNull() && actionType != "block_message" {
+			resp.Diagnostics.AddAttributeError(actionPath.AtName("custom_message"), "Invalid action", "custom_message can only be set on block_message actions.")
+		}
+		if action.ChannelID.IsNull() == (actionType == "send_alert_message") {
+			resp.Diagnostics.AddAttributeError(actionPath.AtName("channel_id"), "Invalid action", "channel_id is required for send_alert_message actions, and can only be set on them.")
+		}
+		if action.DurationSeconds.IsNull() == (actionType == "timeout") {
+			resp.Diagnostics.AddAttributeError(actionPath.AtName("duration_seconds"), "Invalid action", "duration_seconds is required for timeout actions, and can only be set on them.")
+		}
+		if actionType == "timeout" && trigger != "keyword" && trigger != "mention_spam" {
+			resp.Diagnostics.AddAttributeError(actionPath.AtName("type"), "Invalid action", fmt.Sprintf("timeout actions cannot be used with %s rules.", trigger))
+		}
+		if actionType == "block_member_interaction" && trigger != "member_profile" {
+			resp.Diagnostics.AddAttributeError(actionPath.AtName("type"), "Invalid action", fmt.Sprintf("block_member_interaction actions cannot be used with %s rules.", trigger))
+		}
+	}
+}
+
+func (r *DiscordAutoModRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
+func (r *DiscordAutoModRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordAutoModRuleModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params, diags := buildAutoModRuleParams(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := data.ServerID.ValueString()
+	rule, err := utils.AutoModerationRuleCreateComplex(r.client.Session, serverID, params, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create AutoMod rule", err.Error())
+		return
+	}
+
+	model, diags := buildAutoModRuleModel(ctx, serverID, rule, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordAutoModRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordAutoModRuleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	rule, err := utils.GetAutoModerationRule(r.client.Session, serverID, data.ID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "automod rule", data.ID.ValueString())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get AutoMod rule %s", data.ID.ValueString()), err.Error())
+		return
+	}
+
+	model, diags := buildAutoModRuleModel(ctx, serverID, rule, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordAutoModRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DiscordAutoModRuleModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params, diags := buildAutoModRuleParams(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := data.ServerID.ValueString()
+	rule, err := utils.AutoModerationRuleEditComplex(r.client.Session, serverID, data.ID.ValueString(), params, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update AutoMod rule %s", data.ID.ValueString()), err.Error())
+		return
+	}
+
+	model, diags := buildAutoModRuleModel(ctx, serverID, rule, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordAutoModRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordAutoModRuleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.Session.AutoModerationRuleDelete(data.ServerID.ValueString(), data.ID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete AutoMod rule %s", data.ID.ValueString()), err.Error())
+		return
+	}
+}
+
+func (r *DiscordAutoModRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverID, ruleID, ok := strings.Cut(req.ID, ":")
+	if !ok || serverID == "" || ruleID == "" {
+		resp.Diagnostics.AddError("error importing Discord AutoMod Rule", "invalid ID specified. Please specify the ID as \"server_id:rule_id\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ruleID)...)
+}
+
+// buildAutoModRuleParams returns the rule to send. Member profile rules are checked when members update their
+// profile, every other rule when a message is sent.
+func buildAutoModRuleParams(ctx context.Context, data DiscordAutoModRuleModel) (*utils.AutoModerationRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	params := &utils.AutoModerationRule{
+		Name:            data.Name.ValueString(),
+		EventType:       discordgo.AutoModerationEventMessageSend,
+		TriggerMetadata: &utils.AutoModerationTriggerMetadata{},
+		Enabled:         data.Enabled.ValueBool(),
+	}
+	for triggerType, name := range autoModTriggerTypes {
+		if name == data.TriggerType.ValueString() {
+			params.TriggerType = triggerType
+		}
+	}
+	if params.TriggerType == utils.AutoModerationEventTriggerMemberProfile {
+		params.EventType = utils.AutoModerationEventMemberUpdate
+	}
+
+	metadata := params.TriggerMetadata
+	diags.Append(data.KeywordFilter.ElementsAs(ctx, &metadata.KeywordFilter, false)...)
+	diags.Append(data.RegexPatterns.ElementsAs(ctx, &metadata.RegexPatterns, false)...)
+	diags.Append(data.AllowList.ElementsAs(ctx, &metadata.AllowList, false)...)
+	var presets []string
+	diags.Append(data.Presets.ElementsAs(ctx, &presets, false)...)
+	for _, preset := range presets {
+		for value, name := range autoModPresets {
+			if name == preset {
+				metadata.Presets = append(metadata.Presets, value)
+			}
+		}
+	}
+	sort.Slice(metadata.Presets, func(i, j int) bool { return metadata.Presets[i] < metadata.Presets[j] })
+	metadata.MentionTotalLimit = int(data.MentionTotalLimit.ValueInt64())
+	metadata.MentionRaidProtectionEnabled = data.MentionRaidProtectionEnabled.ValueBool()
+
+	for _, action := range data.Action {
+		ruleAction := utils.AutoModerationAction{}
+		for actionType, name := range autoModActionTypes {
+			if name == action.Type.ValueString() {
+				ruleAction.Type = actionType
+			}
+		}
+		if !action.CustomMessage.IsNull() || !action.ChannelID.IsNull() || !action.DurationSeconds.IsNull() {
+			ruleAction.Metadata = &utils.AutoModerationActionMetadata{
+				ChannelID:     action.ChannelID.ValueString(),
+				Duration:      int(action.DurationSeconds.ValueInt64()),
+				CustomMessage: action.CustomMessage.ValueString(),
+			}
+		}
+		params.Actions = append(params.Actions, ruleAction)
+	}
+
+	diags.Append(data.ExemptRoles.ElementsAs(ctx, &params.ExemptRoles, false)...)
+	diags.Append(data.ExemptChannels.ElementsAs(ctx, &params.ExemptChannels, false)...)
+
+	return params, diags
+}
+
+func buildAutoModRuleModel(ctx context.Context, serverID string, rule *utils.AutoModerationRule, data DiscordAutoModRuleModel) (*DiscordAutoModRuleModel, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+	metadata := rule.TriggerMetadata
+	if metadata == nil {
+		metadata = &utils.AutoModerationTriggerMetadata{}
+	}
+	model := &DiscordAutoModRuleModel{
+		ID:                           types.StringValue(rule.ID),
+		ServerID:                     types.StringValue(serverID),
+		Name:                         types.StringValue(rule.Name),
+		TriggerType:                  types.StringValue(autoModTriggerTypes[rule.TriggerType]),
+		MentionTotalLimit:            types.Int64Null(),
+		MentionRaidProtectionEnabled: types.BoolNull(),
+		Enabled:                      types.BoolValue(rule.Enabled),
+		AuditLogReason:               data.AuditLogReason,
+	}
+	if rule.TriggerType == utils.AutoModerationEventTriggerMentionSpam {
+		model.MentionTotalLimit = types.Int64Value(int64(metadata.MentionTotalLimit))
+		model.MentionRaidProtectionEnabled = types.BoolValue(metadata.MentionRaidProtectionEnabled)
+	}
+
+	presets := make([]string, 0, len(metadata.Presets))
+	for _, preset := range metadata.Presets {
+		presets = append(presets, autoModPresets[preset])
+	}
+	model.KeywordFilter, d = utils.StringSetOrNull(ctx, metadata.KeywordFilter, data.KeywordFilter)
+	diags.Append(d...)
+	model.RegexPatterns, d = utils.StringSetOrNull(ctx, metadata.RegexPatterns, data.RegexPatterns)
+	diags.Append(d...)
+	model.Presets, d = utils.StringSetOrNull(ctx, presets, data.Presets)
+	diags.Append(d...)
+	model.AllowList, d = utils.StringSetOrNull(ctx, metadata.AllowList, data.AllowList)
+	diags.Append(d...)
+	model.ExemptRoles, d = utils.StringSetOrNull(ctx, rule.ExemptRoles, data.ExemptRoles)
+	diags.Append(d...)
+	model.ExemptChannels, d = utils.StringSetOrNull(ctx, rule.ExemptChannels, data.ExemptChannels)
+	diags.Append(d...)
+
+	for _, action := range rule.Actions {
+		actionModel := DiscordAutoModRuleActionModel{
+			Type:            types.StringValue(autoModActionTypes[action.Type]),
+			CustomMessage:   types.StringNull(),
+			ChannelID:       types.StringNull(),
+			DurationSeconds: types.Int64Null(),
+		}
+		if action.Metadata != nil {
+			actionModel.CustomMessage = utils.StringValueOrNull(action.Metadata.CustomMessage)
+			actionModel.ChannelID = utils.StringValueOrNull(action.Metadata.ChannelID)
+			if action.Metadata.Duration > 0 {
+				actionModel.DurationSeconds = types.Int64Value(int64(action.Metadata.Duration))
+			}
+		}
+		model.Action = append(model.Action, actionModel)
+	}
+
+	return model, diags
+}
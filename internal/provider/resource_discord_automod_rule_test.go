@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceDiscordAutoModRule(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	testRoleID := os.Getenv("DISCORD_TEST_ROLE_ID")
+	if testServerID == "" || testChannelID == "" || testRoleID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID, DISCORD_TEST_CHANNEL_ID and DISCORD_TEST_ROLE_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_automod_rule.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordAutoModRule(testServerID, "keyword", `keyword_filter = ["spoiler*"]
+  presets = ["slurs"]
+  action {
+    type = "block_message"
+  }`),
+				ExpectError: regexp.MustCompile("presets cannot be used with keyword rules"),
+			},
+			{
+				Config: testAccResourceDiscordAutoModRule(testServerID, "spam", `action {
+    type = "timeout"
+    duration_seconds = 60
+  }
+  action {
+    type = "send_alert_message"
+  }`),
+				ExpectError: regexp.MustCompile(`(?s)timeout actions cannot be used with spam rules.*channel_id is required for send_alert_message`),
+			},
+			{
+				Config: testAccResourceDiscordAutoModRule(testServerID, "keyword", fmt.Sprintf(`keyword_filter = ["spoiler*", "*leak*"]
+  regex_patterns = ["s+p+o+i+l+e+r"]
+  allow_list = ["spoiler-free"]
+  exempt_roles = [%[1]q]
+  action {
+    type = "block_message"
+    custom_message = "No spoilers here"
+  }
+  action {
+    type = "send_alert_message"
+    channel_id = %[2]q
+  }
+  action {
+    type = "timeout"
+    duration_seconds = 60
+  }`, testRoleID, testChannelID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "terraform-keyword"),
+					resource.TestCheckResourceAttr(name, "trigger_type", "keyword"),
+					resource.TestCheckResourceAttr(name, "keyword_filter.#", "2"),
+					resource.TestCheckTypeSetElemAttr(name, "keyword_filter.*", "*leak*"),
+					resource.TestCheckResourceAttr(name, "regex_patterns.#", "1"),
+					resource.TestCheckResourceAttr(name, "allow_list.#", "1"),
+					resource.TestCheckResourceAttr(name, "exempt_roles.#", "1"),
+					resource.TestCheckNoResourceAttr(name, "exempt_channels"),
+					resource.TestCheckNoResourceAttr(name, "mention_raid_protection_enabled"),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "action.#", "3"),
+					resource.TestCheckResourceAttr(name, "action.0.custom_message", "No spoilers here"),
+					resource.TestCheckResourceAttr(name, "action.1.channel_id", testChannelID),
+					resource.TestCheckResourceAttr(name, "action.2.duration_seconds", "60"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordAutoModRule(testServerID, "keyword", fmt.Sprintf(`regex_patterns = ["s+p+o+i+l+e+r"]
+  exempt_channels = [%[1]q]
+  enabled = false
+  action {
+    type = "block_message"
+  }`, testChannelID)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(name, "keyword_filter"),
+					resource.TestCheckNoResourceAttr(name, "allow_list"),
+					resource.TestCheckNoResourceAttr(name, "exempt_roles"),
+					resource.TestCheckResourceAttr(name, "exempt_channels.#", "1"),
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+					resource.TestCheckResourceAttr(name, "action.#", "1"),
+					resource.TestCheckNoResourceAttr(name, "action.0.custom_message"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s:", testServerID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"audit_log_reason"},
+			},
+		},
+	})
+}
+
+func TestAccResourceDiscordAutoModRuleTriggers(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	if testServerID == "" || testChannelID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID and DISCORD_TEST_CHANNEL_ID envvars must be set for acceptance tests")
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordAutoModRuleTriggers(testServerID, testChannelID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("discord_automod_rule.mentions", "mention_total_limit", "5"),
+					resource.TestCheckResourceAttr("discord_automod_rule.mentions", "mention_raid_protection_enabled", "true"),
+					resource.TestCheckResourceAttr("discord_automod_rule.presets", "presets.#", "2"),
+					resource.TestCheckTypeSetElemAttr("discord_automod_rule.presets", "presets.*", "sexual_content"),
+					resource.TestCheckResourceAttr("discord_automod_rule.profile", "action.0.type", "block_member_interaction"),
+					resource.TestCheckResourceAttr("discord_automod_rule.spam", "action.0.type", "send_alert_message"),
+				),
+			},
+			{
+				ResourceName:            "discord_automod_rule.mentions",
+				ImportState:             true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s:", testServerID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"audit_log_reason"},
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordAutoModRule(serverID string, triggerType string, rule string) string {
+	return fmt.Sprintf(`
+	resource "discord_automod_rule" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-%[2]s"
+	  trigger_type = "%[2]s"
+	  %[3]s
+	  audit_log_reason = "Managed by Terraform"
+	}`, serverID, triggerType, rule)
+}
+
+func testAccResourceDiscordAutoModRuleTriggers(serverID string, channelID string) string {
+	return fmt.Sprintf(`
+	resource "discord_automod_rule" "mentions" {
+	  server_id = "%[1]s"
+	  name = "terraform-mentions"
+	  trigger_type = "mention_spam"
+	  mention_total_limit = 5
+	  mention_raid_protection_enabled = true
+	  action {
+	    type = "timeout"
+	    duration_seconds = 600
+	  }
+	}
+
+	resource "discord_automod_rule" "presets" {
+	  server_id = "%[1]s"
+	  name = "terraform-presets"
+	  trigger_type = "keyword_preset"
+	  presets = ["profanity", "sexual_content"]
+	  allow_list = ["scunthorpe"]
+	  action {
+	    type = "block_message"
+	  }
+	}
+
+	resource "discord_automod_rule" "profile" {
+	  server_id = "%[1]s"
+	  name = "terraform-profile"
+	  trigger_type = "member_profile"
+	  keyword_filter = ["free nitro"]
+	  action {
+	    type = "block_member_interaction"
+	  }
+	}
+
+	resource "discord_automod_rule" "spam" {
+	  server_id = "%[1]s"
+	  name = "terraform-spam"
+	  trigger_type = "spam"
+	  action {
+	    type = "send_alert_message"
+	    channel_id = "%[2]s"
+	  }
+	}`, serverID, channelID)
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordBanResource{}
+var _ resource.ResourceWithImportState = &DiscordBanResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordBanResource{}
+
+func NewDiscordBanResource() resource.Resource {
+	return &DiscordBanResource{}
+}
+
+type DiscordBanResource struct {
+	client *Context
+}
+
+type DiscordBanModel struct {
+	ID                   types.String `tfsdk:"id"`
+	ServerID             types.String `tfsdk:"server_id"`
+	UserID               types.String `tfsdk:"user_id"`
+	Reason               types.String `tfsdk:"reason"`
+	DeleteMessageSeconds types.Int64  `tfsdk:"delete_message_seconds"`
+}
+
+func (r *DiscordBanResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ban"
+}
+
+func (r *DiscordBanResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Ban Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the ban in the form `<server id>:<user id>`",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": utils.ServerIDAttribute(),
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user to ban. The user does not have to be a member of the server",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				Description: "The reason for the ban, also shown in the server audit log. Defaults to the provider `audit_log_reason`. Changing it bans the user again",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_message_seconds": schema.Int64Attribute{
+				Description: "The number of seconds of messages from the user to delete when banning them, up to `604800` (7 days). Only used when the ban is created",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 604800),
+				},
+			},
+		},
+	}
+}
+
+func (r *DiscordBanResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DiscordBanResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
+func (r *DiscordBanResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordBanModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	serverID := data.ServerID.ValueString()
+	userID := data.UserID.ValueString()
+	if err := utils.BanCreateComplex(client, serverID, userID, &utils.BanCreate{
+		DeleteMessageSeconds: int(data.DeleteMessageSeconds.ValueInt64()),
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.Reason)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to ban user %s", userID), err.Error())
+		return
+	}
+	ban, err := client.GuildBan(serverID, userID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get ban of user %s", userID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildBanModel(serverID, ban, data.DeleteMessageSeconds))...)
+}
+
+func (r *DiscordBanResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordBanModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	serverID := data.ServerID.ValueString()
+	ban, err := client.GuildBan(serverID, data.UserID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "ban", data.ID.ValueString())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get ban of user %s", data.UserID.ValueString()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildBanModel(serverID, ban, data.DeleteMessageSeconds))...)
+}
+
+func (r *DiscordBanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DiscordBanModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only delete_message_seconds can change without banning the user again, and it is only used by Create.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscordBanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordBanModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	err := client.GuildBanDelete(data.ServerID.ValueString(), data.UserID.ValueString(), discordgo.WithContext(ctx))
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to unban user %s", data.UserID.ValueString()), err.Error())
+		return
+	}
+}
+
+func (r *DiscordBanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverID, userID, ok := strings.Cut(req.ID, ":")
+	if !ok || serverID == "" || userID == "" {
+		resp.Diagnostics.AddError("error importing Discord Ban", "invalid ID specified. Please specify the ID as \"<server id>:<user id>\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_message_seconds"), 0)...)
+}
+
+func buildBanModel(serverID string, ban *discordgo.GuildBan, deleteMessageSeconds types.Int64) *DiscordBanModel {
+	return &DiscordBanModel{
+		ID:                   types.StringValue(fmt.Sprintf("%s:%s", serverID, ban.User.ID)),
+		ServerID:             types.StringValue(serverID),
+		UserID:               types.StringValue(ban.User.ID),
+		Reason:               utils.StringValueOrNull(ban.Reason),
+		DeleteMessageSeconds: deleteMessageSeconds,
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccResourceDiscordBan(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testUserID := os.Getenv("DISCORD_TEST_BAN_USER_ID")
+	if testServerID == "" || testUserID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID and DISCORD_TEST_BAN_USER_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_ban.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if _, err := testAccClient(t).Session.GuildBan(testServerID, testUserID); err == nil {
+				return fmt.Errorf("user %s is still banned", testUserID)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordBan(testServerID, testUserID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", fmt.Sprintf("%s:%s", testServerID, testUserID)),
+					resource.TestCheckResourceAttr(name, "reason", "Raid account"),
+					resource.TestCheckResourceAttr(name, "delete_message_seconds", "3600"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.discord_bans.example", "bans.*", map[string]string{
+						"user_id": testUserID,
+						"reason":  "Raid account",
+					}),
+				),
+			},
+			{
+				// A ban lifted outside Terraform is made again.
+				PreConfig: func() {
+					if err := testAccClient(t).Session.GuildBanDelete(testServerID, testUserID); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccResourceDiscordBan(testServerID, testUserID),
+				Check:  resource.TestCheckResourceAttr(name, "reason", "Raid account"),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// delete_message_seconds is only used when the ban is created.
+				ImportStateVerifyIgnore: []string{"delete_message_seconds"},
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordBan(serverID string, userID string) string {
+	return fmt.Sprintf(`
+	resource "discord_ban" "example" {
+	  server_id = "%[1]s"
+	  user_id = "%[2]s"
+	  reason = "Raid account"
+	  delete_message_seconds = 3600
+	}
+
+	data "discord_bans" "example" {
+	  server_id = discord_ban.example.server_id
+	}`, serverID, userID)
+}
@@ -1,5 +1,5 @@
 // Code generated by go generate in tools; DO NOT EDIT.
-// Generated at: 2025-02-01T21:25:19-05:00
+// Generated at: 2026-10-17T20:42:54Z
 
 package provider
 
@@ -18,11 +18,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DiscordTextChannelResource{}
 var _ resource.ResourceWithImportState = &DiscordTextChannelResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordCategoryChannelResource{}
 
 func NewDiscordCategoryChannelResource() resource.Resource {
 	return &DiscordCategoryChannelResource{}
@@ -44,14 +46,11 @@ func (r *DiscordCategoryChannelResource) Schema(ctx context.Context, req resourc
 			"id": schema.StringAttribute{
 				Description: "The channel ID",
 				Computed:    true,
-			},
-			"server_id": schema.StringAttribute{
-				Description: "The server ID",
-				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"server_id": utils.ServerIDAttribute(),
 			"name": schema.StringAttribute{
 				Description: "The channel name",
 				Required:    true,
@@ -59,6 +58,9 @@ func (r *DiscordCategoryChannelResource) Schema(ctx context.Context, req resourc
 			"channel_id": schema.StringAttribute{
 				Description: "The channel ID",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"type": schema.StringAttribute{
 				Description:        "The channel type",
@@ -66,6 +68,7 @@ func (r *DiscordCategoryChannelResource) Schema(ctx context.Context, req resourc
 				DeprecationMessage: "This field is deprecated. Type is now inferred from the resource name.",
 				Computed:           true,
 			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
 			"position": schema.Int64Attribute{
 				Description: "Sorting position of the channel",
 				Optional:    true,
@@ -97,13 +100,21 @@ func (r *DiscordCategoryChannelResource) Configure(ctx context.Context, req reso
 	r.client = client
 }
 
+func (r *DiscordCategoryChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
 type DiscordCategoryChannel struct {
-	ID        types.String `tfsdk:"id"`
-	ServerID  types.String `tfsdk:"server_id"`
-	ChannelID types.String `tfsdk:"channel_id"`
-	Type      types.String `tfsdk:"type"`
-	Name      types.String `tfsdk:"name"`
-	Position  types.Int64  `tfsdk:"position"`
+	ID             types.String `tfsdk:"id"`
+	ServerID       types.String `tfsdk:"server_id"`
+	ChannelID      types.String `tfsdk:"channel_id"`
+	Type           types.String `tfsdk:"type"`
+	Name           types.String `tfsdk:"name"`
+	Position       types.Int64  `tfsdk:"position"`
+	AuditLogReason types.String `tfsdk:"audit_log_reason"`
 }
 
 func (r *DiscordCategoryChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -120,12 +131,12 @@ func (r *DiscordCategoryChannelResource) Create(ctx context.Context, req resourc
 		resp.Diagnostics.AddError("Failed to build channel params", err.Error())
 		return
 	}
-	channel, err := client.GuildChannelCreateComplex(data.ServerID.ValueString(), channelParams, discordgo.WithContext(ctx))
+	channel, err := client.GuildChannelCreateComplex(data.ServerID.ValueString(), channelParams, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create a channel", err.Error())
 		return
 	}
-	data, err = buildCategoryChannelModel(channel)
+	data, err = buildCategoryChannelModel(channel, data.AuditLogReason)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
 		return
@@ -144,12 +155,16 @@ func (r *DiscordCategoryChannelResource) Read(ctx context.Context, req resource.
 	}
 	client := r.client.Session
 	channel, err := client.Channel(data.ChannelID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "channel", data.ChannelID.ValueString())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
 		return
 	}
 
-	data, err = buildCategoryChannelModel(channel)
+	data, err = buildCategoryChannelModel(channel, data.AuditLogReason)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
 		return
@@ -181,16 +196,17 @@ func (r *DiscordCategoryChannelResource) Update(ctx context.Context, req resourc
 
 	}
 
-	channel, err = client.ChannelEditComplex(data.ChannelID.ValueString(), &discordgo.ChannelEdit{
+	channelEdit := discordgo.ChannelEdit{
 		Name:     channelParams.Name,
 		Position: &channelParams.Position,
-	}, discordgo.WithContext(ctx))
+	}
+	channel, err = client.ChannelEditComplex(data.ChannelID.ValueString(), &channelEdit, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update channel", err.Error())
 		return
 	}
 
-	data, err = buildCategoryChannelModel(channel)
+	data, err = buildCategoryChannelModel(channel, data.AuditLogReason)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
 		return
@@ -209,14 +225,24 @@ func (r *DiscordCategoryChannelResource) Delete(ctx context.Context, req resourc
 	}
 	client := r.client.Session
 
-	if _, err := client.ChannelDelete(data.ChannelID.ValueString(), discordgo.WithContext(ctx)); err != nil {
+	if _, err := client.ChannelDelete(data.ChannelID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
 		resp.Diagnostics.AddError("Failed to delete channel", err.Error())
 		return
 	}
 }
 
 func (r *DiscordCategoryChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("channel_id"), req, resp)
+	// Both "<channel id>" and "<server id>:<channel id>" are accepted.
+	channelID := req.ID
+	if serverID, id, found := strings.Cut(req.ID, ":"); found {
+		if serverID == "" || id == "" {
+			resp.Diagnostics.AddError("error importing Discord channel", "invalid ID specified. Please specify the ID as \"channel_id\" or \"server_id:channel_id\"")
+			return
+		}
+		channelID = id
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelID)...)
 }
 
 func buildCategoryChannelParams(data *DiscordCategoryChannel) (discordgo.GuildChannelCreateData, error) {
@@ -235,18 +261,19 @@ func buildCategoryChannelParams(data *DiscordCategoryChannel) (discordgo.GuildCh
 
 }
 
-func buildCategoryChannelModel(channel *discordgo.Channel) (*DiscordCategoryChannel, error) {
+func buildCategoryChannelModel(channel *discordgo.Channel, auditLogReason types.String) (*DiscordCategoryChannel, error) {
 	channelType, okay := utils.GetTextChannelType(channel.Type)
 	if !okay {
 		return &DiscordCategoryChannel{}, fmt.Errorf("invalid channel type: %s", channelType)
 	}
 
 	return &DiscordCategoryChannel{
-		ID:        types.StringValue(channel.ID),
-		ServerID:  types.StringValue(channel.GuildID),
-		ChannelID: types.StringValue(channel.ID),
-		Type:      types.StringValue(channelType),
-		Name:      types.StringValue(channel.Name),
-		Position:  types.Int64Value(int64(channel.Position)),
+		ID:             types.StringValue(channel.ID),
+		ServerID:       types.StringValue(channel.GuildID),
+		ChannelID:      types.StringValue(channel.ID),
+		Type:           types.StringValue(channelType),
+		Name:           types.StringValue(channel.Name),
+		Position:       types.Int64Value(int64(channel.Position)),
+		AuditLogReason: auditLogReason,
 	}, nil
 }
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceDiscordChannelForum(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_forum_channel.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordForumChannel(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "terraform-forum-channel"),
+					resource.TestCheckResourceAttr(name, "type", "forum"),
+					resource.TestCheckResourceAttr(name, "position", "1"),
+					resource.TestCheckResourceAttrSet(name, "channel_id"),
+					resource.TestCheckResourceAttr(name, "topic", "Testing forum channel"),
+					resource.TestCheckResourceAttr(name, "available_tag.#", "2"),
+					resource.TestCheckResourceAttr(name, "available_tag.0.name", "question"),
+					resource.TestCheckResourceAttr(name, "available_tag.0.emoji_name", "❓"),
+					resource.TestCheckResourceAttr(name, "available_tag.1.name", "resolved"),
+					resource.TestCheckResourceAttr(name, "available_tag.1.moderated", "true"),
+					resource.TestCheckResourceAttrSet(name, "available_tag.0.id"),
+					resource.TestCheckResourceAttr(name, "default_reaction_emoji_name", "👍"),
+					resource.TestCheckResourceAttr(name, "default_sort_order", "creation_date"),
+					resource.TestCheckResourceAttr(name, "default_forum_layout", "list"),
+					resource.TestCheckResourceAttr(name, "require_tag", "true"),
+					resource.TestCheckResourceAttr(name, "default_auto_archive_duration", "4320"),
+					resource.TestCheckResourceAttr(name, "default_thread_slowmode", "30"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// A forum whose settings Discord rejects is deleted again instead of being left behind.
+func TestAccResourceDiscordChannelForumSettingsFailure(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "discord_forum_channel" "example" {
+				  server_id = "%s"
+				  name = "terraform-forum-settings-failure"
+				  default_reaction_emoji_id = "1"
+				}`, testServerID),
+				ExpectError: regexp.MustCompile("Failed to update forum settings of channel"),
+			},
+			{
+				PreConfig: func() {
+					channels, err := testAccClient(t).Session.GuildChannels(testServerID)
+					if err != nil {
+						t.Fatal(err)
+					}
+					for _, channel := range channels {
+						if channel.Name == "terraform-forum-settings-failure" {
+							t.Fatalf("expected channel %s to be deleted", channel.ID)
+						}
+					}
+				},
+				Config: " ",
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordForumChannel(serverID string) string {
+	return fmt.Sprintf(`
+	resource "discord_forum_channel" "example" {
+	  server_id = "%[1]s"
+      name = "terraform-forum-channel"
+      position = 1
+      topic = "Testing forum channel"
+      sync_perms_with_category = false
+      default_reaction_emoji_name = "👍"
+      default_sort_order = "creation_date"
+      default_forum_layout = "list"
+      require_tag = true
+      default_auto_archive_duration = 4320
+      default_thread_slowmode = 30
+
+      available_tag {
+        name = "question"
+        emoji_name = "❓"
+      }
+
+      available_tag {
+        name = "resolved"
+        moderated = true
+      }
+	}`, serverID)
+}
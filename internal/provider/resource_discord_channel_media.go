@@ -0,0 +1,552 @@
+// Code generated by go generate in tools; DO NOT EDIT.
+// Generated at: 2026-10-17T20:42:54Z
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordTextChannelResource{}
+var _ resource.ResourceWithImportState = &DiscordTextChannelResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordMediaChannelResource{}
+
+func NewDiscordMediaChannelResource() resource.Resource {
+	return &DiscordMediaChannelResource{}
+}
+
+type DiscordMediaChannelResource struct {
+	client *Context
+}
+
+func (r *DiscordMediaChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_media_channel"
+}
+
+func (r *DiscordMediaChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Media Channel Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The channel ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": utils.ServerIDAttribute(),
+			"name": schema.StringAttribute{
+				Description: "The channel name",
+				Required:    true,
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The channel ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:        "The channel type",
+				Optional:           true,
+				DeprecationMessage: "This field is deprecated. Type is now inferred from the resource name.",
+				Computed:           true,
+			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
+			"position": schema.Int64Attribute{
+				Description: "Sorting position of the channel",
+				Optional:    true,
+				Default:     int64default.StaticInt64(1),
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"sync_perms_with_category": schema.BoolAttribute{
+				Description: "Whether to sync permissions with the category",
+				Optional:    true,
+				Default:     booldefault.StaticBool(true),
+				Computed:    true,
+			},
+			"permissions_synced": schema.BoolAttribute{
+				Description: "Whether the permissions are synced with the category",
+				Computed:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "The category ID",
+				Optional:    true,
+				Computed:    true,
+			},
+			"topic": schema.StringAttribute{
+				Description: "The channel topic",
+				Optional:    true,
+			},
+			"default_reaction_emoji_id": schema.StringAttribute{
+				Description: "The ID of the custom emoji used as the default reaction on posts",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("default_reaction_emoji_name")),
+				},
+			},
+			"default_reaction_emoji_name": schema.StringAttribute{
+				Description: "The unicode emoji used as the default reaction on posts",
+				Optional:    true,
+			},
+			"default_sort_order": schema.StringAttribute{
+				Description: "The default sort order of posts. One of `latest_activity` or `creation_date`",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("latest_activity", "creation_date"),
+				},
+			},
+			"require_tag": schema.BoolAttribute{
+				Description: "Whether a tag is required when creating a post",
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+				Computed:    true,
+			},
+			"default_auto_archive_duration": schema.Int64Attribute{
+				Description: "The default duration in minutes after which new posts are archived. One of `60`, `1440`, `4320` or `10080`",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(60, 1440, 4320, 10080),
+				},
+			},
+			"default_thread_slowmode": schema.Int64Attribute{
+				Description: "The default slowmode in seconds applied to new posts",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 21600),
+				},
+			},
+			"slowmode": schema.Int64Attribute{
+				Description: "The slowmode in seconds members have to wait between creating posts",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 21600),
+				},
+			},
+			"hide_media_download_options": schema.BoolAttribute{
+				Description: "Whether to hide the download options of media embedded in posts",
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+				Computed:    true,
+			}},
+		Blocks: map[string]schema.Block{
+			"available_tag": schema.ListNestedBlock{
+				Description: "Tags that can be applied to posts",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The tag ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The tag name",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 20),
+							},
+						},
+						"moderated": schema.BoolAttribute{
+							Description: "Whether the tag can only be applied by members with the Manage Threads permission",
+							Optional:    true,
+							Default:     booldefault.StaticBool(false),
+							Computed:    true,
+						},
+						"emoji_id": schema.StringAttribute{
+							Description: "The ID of the custom emoji of the tag",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("emoji_name")),
+							},
+						},
+						"emoji_name": schema.StringAttribute{
+							Description: "The unicode emoji of the tag",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DiscordMediaChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DiscordMediaChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
+type DiscordMediaChannel struct {
+	ID                         types.String                 `tfsdk:"id"`
+	ServerID                   types.String                 `tfsdk:"server_id"`
+	ChannelID                  types.String                 `tfsdk:"channel_id"`
+	Type                       types.String                 `tfsdk:"type"`
+	Name                       types.String                 `tfsdk:"name"`
+	Position                   types.Int64                  `tfsdk:"position"`
+	AuditLogReason             types.String                 `tfsdk:"audit_log_reason"`
+	SyncPermsWithCategory      types.Bool                   `tfsdk:"sync_perms_with_category"`
+	Category                   types.String                 `tfsdk:"category"`
+	PermissionsSynced          types.Bool                   `tfsdk:"permissions_synced"`
+	Topic                      types.String                 `tfsdk:"topic"`
+	AvailableTags              []utils.DiscordForumTagModel `tfsdk:"available_tag"`
+	DefaultReactionEmojiID     types.String                 `tfsdk:"default_reaction_emoji_id"`
+	DefaultReactionEmojiName   types.String                 `tfsdk:"default_reaction_emoji_name"`
+	DefaultSortOrder           types.String                 `tfsdk:"default_sort_order"`
+	RequireTag                 types.Bool                   `tfsdk:"require_tag"`
+	DefaultAutoArchiveDuration types.Int64                  `tfsdk:"default_auto_archive_duration"`
+	DefaultThreadSlowmode      types.Int64                  `tfsdk:"default_thread_slowmode"`
+	Slowmode                   types.Int64                  `tfsdk:"slowmode"`
+	HideMediaDownloadOptions   types.Bool                   `tfsdk:"hide_media_download_options"`
+}
+
+func (r *DiscordMediaChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DiscordMediaChannel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	channelParams, err := buildMediaChannelParams(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build channel params", err.Error())
+		return
+	}
+	forumParams, err := buildMediaChannelEdit(data, discordgo.ChannelEdit{}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build forum params", err.Error())
+		return
+	}
+	channel, err := client.GuildChannelCreateComplex(data.ServerID.ValueString(), channelParams, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create a channel", err.Error())
+		return
+	}
+	forum, err := utils.ForumChannelEditComplex(client, channel.ID, forumParams, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update forum settings of channel", err.Error())
+		// The channel is not in state yet, so it is deleted again instead of being left behind untracked.
+		if _, err := client.ChannelDelete(channel.ID, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete channel %s after its forum settings failed", channel.ID), err.Error())
+		}
+		return
+	}
+	channel = forum.Channel
+	data, err = buildMediaChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
+		return
+	}
+	data.DefaultAutoArchiveDuration = types.Int64Value(int64(forum.DefaultAutoArchiveDuration))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscordMediaChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DiscordMediaChannel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	forum, err := utils.GetForumChannel(client, data.ChannelID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "channel", data.ChannelID.ValueString())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
+		return
+	}
+	channel := forum.Channel
+
+	data, err = buildMediaChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
+		return
+	}
+	data.DefaultAutoArchiveDuration = types.Int64Value(int64(forum.DefaultAutoArchiveDuration))
+	if channel.ParentID == "" {
+		data.PermissionsSynced = types.BoolNull()
+	} else {
+		parent, err := client.Channel(channel.ParentID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to fetch category of channel", err.Error())
+			return
+		}
+
+		data.PermissionsSynced = types.BoolValue(utils.ArePermissionsSynced(channel, parent))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscordMediaChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DiscordMediaChannel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.client.Session
+	channel, err := client.Channel(data.ChannelID.ValueString(), discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
+		return
+	}
+
+	channelParams, err := buildMediaChannelParams(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build channel params", err.Error())
+		return
+
+	}
+	if data.SyncPermsWithCategory.ValueBool() {
+		if channel.ParentID == "" {
+			resp.Diagnostics.AddError("Channel does not have a category", "")
+			return
+		}
+
+		parent, err := client.Channel(channel.ParentID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to fetch category of channel", err.Error())
+			return
+		}
+
+		if !utils.ArePermissionsSynced(channel, parent) {
+			if err := utils.SyncChannelPermissions(client, ctx, parent, channel, utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+				resp.Diagnostics.AddError("Failed to sync permissions with category", err.Error())
+				return
+			}
+		}
+	}
+
+	channelEdit := discordgo.ChannelEdit{
+		Name:     channelParams.Name,
+		Position: &channelParams.Position,
+		Topic:    channelParams.Topic,
+		ParentID: channelParams.ParentID,
+	}
+	forumParams, err := buildMediaChannelEdit(data, channelEdit, channel.AvailableTags)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build forum params", err.Error())
+		return
+	}
+	forum, err := utils.ForumChannelEditComplex(client, data.ChannelID.ValueString(), forumParams, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update channel", err.Error())
+		return
+	}
+	channel = forum.Channel
+
+	data, err = buildMediaChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
+		return
+	}
+	data.DefaultAutoArchiveDuration = types.Int64Value(int64(forum.DefaultAutoArchiveDuration))
+	if channel.ParentID == "" {
+		data.PermissionsSynced = types.BoolNull()
+	} else {
+		parent, err := client.Channel(channel.ParentID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to fetch category of channel", err.Error())
+			return
+		}
+
+		data.PermissionsSynced = types.BoolValue(utils.ArePermissionsSynced(channel, parent))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscordMediaChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DiscordMediaChannel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+
+	if _, err := client.ChannelDelete(data.ChannelID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+		resp.Diagnostics.AddError("Failed to delete channel", err.Error())
+		return
+	}
+}
+
+func (r *DiscordMediaChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Both "<channel id>" and "<server id>:<channel id>" are accepted.
+	channelID := req.ID
+	if serverID, id, found := strings.Cut(req.ID, ":"); found {
+		if serverID == "" || id == "" {
+			resp.Diagnostics.AddError("error importing Discord channel", "invalid ID specified. Please specify the ID as \"channel_id\" or \"server_id:channel_id\"")
+			return
+		}
+		channelID = id
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	}
+	client := r.client.Session
+	channel, err := client.Channel(channelID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
+		return
+	}
+	if channel.ParentID == "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sync_perms_with_category"), false)...)
+	} else {
+		parent, err := client.Channel(channel.ParentID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to fetch category of channel", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sync_perms_with_category"), types.BoolValue(utils.ArePermissionsSynced(channel, parent)))...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelID)...)
+}
+
+func buildMediaChannelParams(data *DiscordMediaChannel) (discordgo.GuildChannelCreateData, error) {
+	if data.Type.ValueString() == "" {
+		data.Type = types.StringValue("media")
+	}
+	channelType, okay := utils.GetDiscordChannelType(data.Type.ValueString())
+	if !okay {
+		return discordgo.GuildChannelCreateData{}, fmt.Errorf("invalid channel type: %s", data.Type.ValueString())
+	}
+	return discordgo.GuildChannelCreateData{
+		Name:     data.Name.ValueString(),
+		Position: int(data.Position.ValueInt64()),
+		Type:     channelType,
+		Topic:    data.Topic.ValueString(),
+		ParentID: data.Category.ValueString(),
+	}, nil
+
+}
+
+func buildMediaChannelModel(channel *discordgo.Channel, auditLogReason types.String, SyncPermsWithCategory types.Bool) (*DiscordMediaChannel, error) {
+	channelType, okay := utils.GetTextChannelType(channel.Type)
+	if !okay {
+		return &DiscordMediaChannel{}, fmt.Errorf("invalid channel type: %s", channelType)
+	}
+	defaultSortOrder := types.StringNull()
+	if sortOrder, okay := utils.GetForumSortOrderTypeString(channel.DefaultSortOrder); okay {
+		defaultSortOrder = types.StringValue(sortOrder)
+	}
+
+	return &DiscordMediaChannel{
+		ID:                       types.StringValue(channel.ID),
+		ServerID:                 types.StringValue(channel.GuildID),
+		ChannelID:                types.StringValue(channel.ID),
+		Type:                     types.StringValue(channelType),
+		Name:                     types.StringValue(channel.Name),
+		Position:                 types.Int64Value(int64(channel.Position)),
+		AuditLogReason:           auditLogReason,
+		Category:                 types.StringValue(channel.ParentID),
+		SyncPermsWithCategory:    SyncPermsWithCategory,
+		Topic:                    types.StringValue(channel.Topic),
+		AvailableTags:            utils.BuildForumTagModels(channel.AvailableTags),
+		DefaultReactionEmojiID:   utils.StringValueOrNull(channel.DefaultReactionEmoji.EmojiID),
+		DefaultReactionEmojiName: utils.StringValueOrNull(channel.DefaultReactionEmoji.EmojiName),
+		DefaultSortOrder:         defaultSortOrder,
+		RequireTag:               types.BoolValue(channel.Flags&discordgo.ChannelFlagRequireTag != 0),
+		DefaultThreadSlowmode:    types.Int64Value(int64(channel.DefaultThreadRateLimitPerUser)),
+		Slowmode:                 types.Int64Value(int64(channel.RateLimitPerUser)),
+		HideMediaDownloadOptions: types.BoolValue(channel.Flags&utils.ChannelFlagHideMediaDownloadOptions != 0),
+	}, nil
+}
+
+func buildMediaChannelEdit(data *DiscordMediaChannel, channelEdit discordgo.ChannelEdit, existingTags []discordgo.ForumTag) (*utils.ForumChannelEdit, error) {
+	availableTags := utils.BuildForumTags(data.AvailableTags, existingTags)
+	channelEdit.AvailableTags = &availableTags
+
+	flags := discordgo.ChannelFlags(0)
+	if data.RequireTag.ValueBool() {
+		flags |= discordgo.ChannelFlagRequireTag
+	}
+	if data.HideMediaDownloadOptions.ValueBool() {
+		flags |= utils.ChannelFlagHideMediaDownloadOptions
+	}
+	if !data.Slowmode.IsNull() && !data.Slowmode.IsUnknown() {
+		slowmode := int(data.Slowmode.ValueInt64())
+		channelEdit.RateLimitPerUser = &slowmode
+	}
+	channelEdit.Flags = &flags
+
+	if !data.DefaultThreadSlowmode.IsNull() && !data.DefaultThreadSlowmode.IsUnknown() {
+		slowmode := int(data.DefaultThreadSlowmode.ValueInt64())
+		channelEdit.DefaultThreadRateLimitPerUser = &slowmode
+	}
+	if data.DefaultSortOrder.ValueString() != "" {
+		sortOrder, okay := utils.GetForumSortOrderType(data.DefaultSortOrder.ValueString())
+		if !okay {
+			return nil, fmt.Errorf("invalid default sort order: %s", data.DefaultSortOrder.ValueString())
+		}
+		channelEdit.DefaultSortOrder = sortOrder
+	}
+
+	forumParams := &utils.ForumChannelEdit{
+		ChannelEdit:                channelEdit,
+		DefaultAutoArchiveDuration: int(data.DefaultAutoArchiveDuration.ValueInt64()),
+	}
+	if data.DefaultReactionEmojiID.ValueString() != "" || data.DefaultReactionEmojiName.ValueString() != "" {
+		forumParams.DefaultReactionEmoji = &discordgo.ForumDefaultReaction{
+			EmojiID:   data.DefaultReactionEmojiID.ValueString(),
+			EmojiName: data.DefaultReactionEmojiName.ValueString(),
+		}
+	}
+
+	return forumParams, nil
+}
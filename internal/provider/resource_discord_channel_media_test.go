@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccResourceDiscordChannelMedia(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_media_channel.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordMediaChannel(testServerID, 300, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "terraform-media-channel"),
+					resource.TestCheckResourceAttr(name, "type", "media"),
+					resource.TestCheckResourceAttrSet(name, "channel_id"),
+					resource.TestCheckResourceAttr(name, "topic", "Post your own work only"),
+					resource.TestCheckResourceAttr(name, "available_tag.#", "2"),
+					resource.TestCheckResourceAttr(name, "available_tag.0.name", "digital"),
+					resource.TestCheckResourceAttr(name, "available_tag.1.name", "traditional"),
+					resource.TestCheckResourceAttr(name, "default_reaction_emoji_name", "🎨"),
+					resource.TestCheckResourceAttr(name, "require_tag", "true"),
+					resource.TestCheckResourceAttr(name, "slowmode", "300"),
+					resource.TestCheckResourceAttr(name, "default_thread_slowmode", "30"),
+					resource.TestCheckResourceAttr(name, "hide_media_download_options", "true"),
+					resource.TestCheckResourceAttrPair(name, "category", "discord_category_channel.example", "channel_id"),
+					resource.TestCheckResourceAttr(name, "sync_perms_with_category", "true"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordMediaChannel(testServerID, 0, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "slowmode", "0"),
+					resource.TestCheckResourceAttr(name, "hide_media_download_options", "false"),
+					resource.TestCheckResourceAttr(name, "require_tag", "true"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s:", testServerID),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordMediaChannel(serverID string, slowmode int, hideMediaDownloadOptions bool) string {
+	return fmt.Sprintf(`
+	resource "discord_category_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-media-category"
+	}
+
+	resource "discord_media_channel" "example" {
+	  server_id = "%[1]s"
+      name = "terraform-media-channel"
+      topic = "Post your own work only"
+      category = discord_category_channel.example.channel_id
+      default_reaction_emoji_name = "🎨"
+      require_tag = true
+      slowmode = %[2]d
+      default_thread_slowmode = 30
+      hide_media_download_options = %[3]t
+
+      available_tag {
+        name = "digital"
+      }
+
+      available_tag {
+        name = "traditional"
+      }
+	}`, serverID, slowmode, hideMediaDownloadOptions)
+}
@@ -1,5 +1,5 @@
 // Code generated by go generate in tools; DO NOT EDIT.
-// Generated at: 2025-02-01T21:25:19-05:00
+// Generated at: 2026-10-17T20:42:54Z
 
 package provider
 
@@ -18,11 +18,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DiscordTextChannelResource{}
 var _ resource.ResourceWithImportState = &DiscordTextChannelResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordNewsChannelResource{}
 
 func NewDiscordNewsChannelResource() resource.Resource {
 	return &DiscordNewsChannelResource{}
@@ -44,14 +46,11 @@ func (r *DiscordNewsChannelResource) Schema(ctx context.Context, req resource.Sc
 			"id": schema.StringAttribute{
 				Description: "The channel ID",
 				Computed:    true,
-			},
-			"server_id": schema.StringAttribute{
-				Description: "The server ID",
-				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"server_id": utils.ServerIDAttribute(),
 			"name": schema.StringAttribute{
 				Description: "The channel name",
 				Required:    true,
@@ -59,6 +58,9 @@ func (r *DiscordNewsChannelResource) Schema(ctx context.Context, req resource.Sc
 			"channel_id": schema.StringAttribute{
 				Description: "The channel ID",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"type": schema.StringAttribute{
 				Description:        "The channel type",
@@ -66,6 +68,7 @@ func (r *DiscordNewsChannelResource) Schema(ctx context.Context, req resource.Sc
 				DeprecationMessage: "This field is deprecated. Type is now inferred from the resource name.",
 				Computed:           true,
 			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
 			"position": schema.Int64Attribute{
 				Description: "Sorting position of the channel",
 				Optional:    true,
@@ -116,6 +119,13 @@ func (r *DiscordNewsChannelResource) Configure(ctx context.Context, req resource
 	r.client = client
 }
 
+func (r *DiscordNewsChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
 type DiscordNewsChannel struct {
 	ID                    types.String `tfsdk:"id"`
 	ServerID              types.String `tfsdk:"server_id"`
@@ -123,6 +133,7 @@ type DiscordNewsChannel struct {
 	Type                  types.String `tfsdk:"type"`
 	Name                  types.String `tfsdk:"name"`
 	Position              types.Int64  `tfsdk:"position"`
+	AuditLogReason        types.String `tfsdk:"audit_log_reason"`
 	SyncPermsWithCategory types.Bool   `tfsdk:"sync_perms_with_category"`
 	Category              types.String `tfsdk:"category"`
 	PermissionsSynced     types.Bool   `tfsdk:"permissions_synced"`
@@ -143,12 +154,12 @@ func (r *DiscordNewsChannelResource) Create(ctx context.Context, req resource.Cr
 		resp.Diagnostics.AddError("Failed to build channel params", err.Error())
 		return
 	}
-	channel, err := client.GuildChannelCreateComplex(data.ServerID.ValueString(), channelParams, discordgo.WithContext(ctx))
+	channel, err := client.GuildChannelCreateComplex(data.ServerID.ValueString(), channelParams, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create a channel", err.Error())
 		return
 	}
-	data, err = buildNewsChannelModel(channel, data.SyncPermsWithCategory)
+	data, err = buildNewsChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
 		return
@@ -167,12 +178,16 @@ func (r *DiscordNewsChannelResource) Read(ctx context.Context, req resource.Read
 	}
 	client := r.client.Session
 	channel, err := client.Channel(data.ChannelID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "channel", data.ChannelID.ValueString())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
 		return
 	}
 
-	data, err = buildNewsChannelModel(channel, data.SyncPermsWithCategory)
+	data, err = buildNewsChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
 		return
@@ -227,25 +242,26 @@ func (r *DiscordNewsChannelResource) Update(ctx context.Context, req resource.Up
 		}
 
 		if !utils.ArePermissionsSynced(channel, parent) {
-			if err := utils.SyncChannelPermissions(client, ctx, parent, channel); err != nil {
+			if err := utils.SyncChannelPermissions(client, ctx, parent, channel, utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
 				resp.Diagnostics.AddError("Failed to sync permissions with category", err.Error())
 				return
 			}
 		}
 	}
 
-	channel, err = client.ChannelEditComplex(data.ChannelID.ValueString(), &discordgo.ChannelEdit{
+	channelEdit := discordgo.ChannelEdit{
 		Name:     channelParams.Name,
 		Position: &channelParams.Position,
 		Topic:    channelParams.Topic,
 		ParentID: channelParams.ParentID,
-	}, discordgo.WithContext(ctx))
+	}
+	channel, err = client.ChannelEditComplex(data.ChannelID.ValueString(), &channelEdit, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update channel", err.Error())
 		return
 	}
 
-	data, err = buildNewsChannelModel(channel, data.SyncPermsWithCategory)
+	data, err = buildNewsChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
 		return
@@ -275,15 +291,25 @@ func (r *DiscordNewsChannelResource) Delete(ctx context.Context, req resource.De
 	}
 	client := r.client.Session
 
-	if _, err := client.ChannelDelete(data.ChannelID.ValueString(), discordgo.WithContext(ctx)); err != nil {
+	if _, err := client.ChannelDelete(data.ChannelID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
 		resp.Diagnostics.AddError("Failed to delete channel", err.Error())
 		return
 	}
 }
 
 func (r *DiscordNewsChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Both "<channel id>" and "<server id>:<channel id>" are accepted.
+	channelID := req.ID
+	if serverID, id, found := strings.Cut(req.ID, ":"); found {
+		if serverID == "" || id == "" {
+			resp.Diagnostics.AddError("error importing Discord channel", "invalid ID specified. Please specify the ID as \"channel_id\" or \"server_id:channel_id\"")
+			return
+		}
+		channelID = id
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	}
 	client := r.client.Session
-	channel, err := client.Channel(req.ID)
+	channel, err := client.Channel(channelID)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
 		return
@@ -298,7 +324,7 @@ func (r *DiscordNewsChannelResource) ImportState(ctx context.Context, req resour
 		}
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sync_perms_with_category"), types.BoolValue(utils.ArePermissionsSynced(channel, parent)))...)
 	}
-	resource.ImportStatePassthroughID(ctx, path.Root("channel_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelID)...)
 }
 
 func buildNewsChannelParams(data *DiscordNewsChannel) (discordgo.GuildChannelCreateData, error) {
@@ -319,7 +345,7 @@ func buildNewsChannelParams(data *DiscordNewsChannel) (discordgo.GuildChannelCre
 
 }
 
-func buildNewsChannelModel(channel *discordgo.Channel, SyncPermsWithCategory types.Bool) (*DiscordNewsChannel, error) {
+func buildNewsChannelModel(channel *discordgo.Channel, auditLogReason types.String, SyncPermsWithCategory types.Bool) (*DiscordNewsChannel, error) {
 	channelType, okay := utils.GetTextChannelType(channel.Type)
 	if !okay {
 		return &DiscordNewsChannel{}, fmt.Errorf("invalid channel type: %s", channelType)
@@ -332,6 +358,7 @@ func buildNewsChannelModel(channel *discordgo.Channel, SyncPermsWithCategory typ
 		Type:                  types.StringValue(channelType),
 		Name:                  types.StringValue(channel.Name),
 		Position:              types.Int64Value(int64(channel.Position)),
+		AuditLogReason:        auditLogReason,
 		Category:              types.StringValue(channel.ParentID),
 		SyncPermsWithCategory: SyncPermsWithCategory,
 		Topic:                 types.StringValue(channel.Topic),
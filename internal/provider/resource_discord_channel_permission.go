@@ -36,6 +36,8 @@ type DiscordChannelPermissionModel struct {
 	OverwriteID types.String `tfsdk:"overwrite_id"`
 	Allow       types.Int64  `tfsdk:"allow"`
 	Deny        types.Int64  `tfsdk:"deny"`
+
+	AuditLogReason types.String `tfsdk:"audit_log_reason"`
 }
 
 func (r *DiscordChannelPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -89,6 +91,7 @@ func (r *DiscordChannelPermissionResource) Schema(ctx context.Context, req resou
 				Computed: true,
 				Default:  int64default.StaticInt64(0),
 			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
 		},
 	}
 }
@@ -129,7 +132,7 @@ func (r *DiscordChannelPermissionResource) Create(ctx context.Context, req resou
 	if err := client.ChannelPermissionSet(
 		channelID, overrideID, permissionType,
 		data.Allow.ValueInt64(),
-		data.Deny.ValueInt64(), discordgo.WithContext(ctx),
+		data.Deny.ValueInt64(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason),
 	); err != nil {
 		resp.Diagnostics.AddError("Failed to set channel permission", err.Error())
 		return
@@ -149,6 +152,10 @@ func (r *DiscordChannelPermissionResource) Read(ctx context.Context, req resourc
 	}
 	client := r.client.Session
 	channel, err := client.Channel(data.ChannelID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "channel", data.ChannelID.ValueString())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Error fetching channel %s", data.ChannelID.ValueString()), err.Error())
 		return
@@ -167,13 +174,15 @@ func (r *DiscordChannelPermissionResource) Read(ctx context.Context, req resourc
 				OverwriteID: types.StringValue(overwrite.ID),
 				Allow:       types.Int64Value(overwrite.Allow),
 				Deny:        types.Int64Value(overwrite.Deny),
+
+				AuditLogReason: data.AuditLogReason,
 			}
 			found = true
 			break
 		}
 	}
 	if !found {
-		resp.Diagnostics.AddError("Failed to find permission overwrite", "Not found")
+		utils.RemoveNotFound(ctx, resp, "permission overwrite", overrideID)
 		return
 	}
 
@@ -196,7 +205,7 @@ func (r *DiscordChannelPermissionResource) Update(ctx context.Context, req resou
 	if err := client.ChannelPermissionSet(
 		channelID, overrideID, permissionType,
 		data.Allow.ValueInt64(),
-		data.Deny.ValueInt64(), discordgo.WithContext(ctx),
+		data.Deny.ValueInt64(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason),
 	); err != nil {
 		resp.Diagnostics.AddError("Failed to update channel permission overwrite", err.Error())
 		return
@@ -214,7 +223,7 @@ func (r *DiscordChannelPermissionResource) Delete(ctx context.Context, req resou
 		return
 	}
 	client := r.client.Session
-	if err := client.ChannelPermissionDelete(data.ChannelID.ValueString(), data.OverwriteID.ValueString(), discordgo.WithContext(ctx)); err != nil {
+	if err := client.ChannelPermissionDelete(data.ChannelID.ValueString(), data.OverwriteID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete channel permissions. channel: %s", data.ChannelID.ValueString()), err.Error())
 		return
 	}
@@ -0,0 +1,401 @@
+// Code generated by go generate in tools; DO NOT EDIT.
+// Generated at: 2026-10-17T20:42:54Z
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordTextChannelResource{}
+var _ resource.ResourceWithImportState = &DiscordTextChannelResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordStageChannelResource{}
+
+func NewDiscordStageChannelResource() resource.Resource {
+	return &DiscordStageChannelResource{}
+}
+
+type DiscordStageChannelResource struct {
+	client *Context
+}
+
+func (r *DiscordStageChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stage_channel"
+}
+
+func (r *DiscordStageChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Stage Channel Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The channel ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": utils.ServerIDAttribute(),
+			"name": schema.StringAttribute{
+				Description: "The channel name",
+				Required:    true,
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The channel ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:        "The channel type",
+				Optional:           true,
+				DeprecationMessage: "This field is deprecated. Type is now inferred from the resource name.",
+				Computed:           true,
+			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
+			"position": schema.Int64Attribute{
+				Description: "Sorting position of the channel",
+				Optional:    true,
+				Default:     int64default.StaticInt64(1),
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"sync_perms_with_category": schema.BoolAttribute{
+				Description: "Whether to sync permissions with the category",
+				Optional:    true,
+				Default:     booldefault.StaticBool(true),
+				Computed:    true,
+			},
+			"permissions_synced": schema.BoolAttribute{
+				Description: "Whether the permissions are synced with the category",
+				Computed:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "The category ID",
+				Optional:    true,
+				Computed:    true,
+			},
+			"topic": schema.StringAttribute{
+				Description: "The channel topic",
+				Optional:    true,
+			},
+			"bitrate": schema.Int64Attribute{
+				Description: "The bitrate of the channel",
+				Optional:    true,
+				Default:     int64default.StaticInt64(64000),
+				Computed:    true,
+			},
+			"user_limit": schema.Int64Attribute{
+				Description: "The user limit of the channel",
+				Optional:    true,
+			},
+			"rtc_region": schema.StringAttribute{
+				Description: "The voice region ID of the channel. Automatic when not set",
+				Optional:    true,
+			}},
+	}
+}
+
+func (r *DiscordStageChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DiscordStageChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
+type DiscordStageChannel struct {
+	ID                    types.String `tfsdk:"id"`
+	ServerID              types.String `tfsdk:"server_id"`
+	ChannelID             types.String `tfsdk:"channel_id"`
+	Type                  types.String `tfsdk:"type"`
+	Name                  types.String `tfsdk:"name"`
+	Position              types.Int64  `tfsdk:"position"`
+	AuditLogReason        types.String `tfsdk:"audit_log_reason"`
+	SyncPermsWithCategory types.Bool   `tfsdk:"sync_perms_with_category"`
+	Category              types.String `tfsdk:"category"`
+	PermissionsSynced     types.Bool   `tfsdk:"permissions_synced"`
+	Topic                 types.String `tfsdk:"topic"`
+	Bitrate               types.Int64  `tfsdk:"bitrate"`
+	UserLimit             types.Int64  `tfsdk:"user_limit"`
+	RTCRegion             types.String `tfsdk:"rtc_region"`
+}
+
+func (r *DiscordStageChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DiscordStageChannel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	channelParams, err := buildStageChannelParams(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build channel params", err.Error())
+		return
+	}
+	voice, err := utils.VoiceChannelCreateComplex(client, data.ServerID.ValueString(), utils.VoiceChannelCreateData{
+		GuildChannelCreateData: channelParams,
+		RTCRegion:              data.RTCRegion.ValueStringPointer(),
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create a channel", err.Error())
+		return
+	}
+	channel := voice.Channel
+	data, err = buildStageChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
+		return
+	}
+	data.RTCRegion = types.StringPointerValue(voice.RTCRegion)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscordStageChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DiscordStageChannel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	voice, err := utils.GetVoiceChannel(client, data.ChannelID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "channel", data.ChannelID.ValueString())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
+		return
+	}
+	channel := voice.Channel
+
+	data, err = buildStageChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
+		return
+	}
+	data.RTCRegion = types.StringPointerValue(voice.RTCRegion)
+	if channel.ParentID == "" {
+		data.PermissionsSynced = types.BoolNull()
+	} else {
+		parent, err := client.Channel(channel.ParentID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to fetch category of channel", err.Error())
+			return
+		}
+
+		data.PermissionsSynced = types.BoolValue(utils.ArePermissionsSynced(channel, parent))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscordStageChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DiscordStageChannel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.client.Session
+	channel, err := client.Channel(data.ChannelID.ValueString(), discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
+		return
+	}
+
+	channelParams, err := buildStageChannelParams(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build channel params", err.Error())
+		return
+
+	}
+	if data.SyncPermsWithCategory.ValueBool() {
+		if channel.ParentID == "" {
+			resp.Diagnostics.AddError("Channel does not have a category", "")
+			return
+		}
+
+		parent, err := client.Channel(channel.ParentID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to fetch category of channel", err.Error())
+			return
+		}
+
+		if !utils.ArePermissionsSynced(channel, parent) {
+			if err := utils.SyncChannelPermissions(client, ctx, parent, channel, utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+				resp.Diagnostics.AddError("Failed to sync permissions with category", err.Error())
+				return
+			}
+		}
+	}
+
+	channelEdit := discordgo.ChannelEdit{
+		Name:      channelParams.Name,
+		Position:  &channelParams.Position,
+		Topic:     channelParams.Topic,
+		Bitrate:   channelParams.Bitrate,
+		UserLimit: channelParams.UserLimit,
+		ParentID:  channelParams.ParentID,
+	}
+	voice, err := utils.VoiceChannelEditComplex(client, data.ChannelID.ValueString(), &utils.VoiceChannelEdit{
+		ChannelEdit: channelEdit,
+		RTCRegion:   data.RTCRegion.ValueStringPointer(),
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update channel", err.Error())
+		return
+	}
+	channel = voice.Channel
+
+	data, err = buildStageChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
+		return
+	}
+	data.RTCRegion = types.StringPointerValue(voice.RTCRegion)
+	if channel.ParentID == "" {
+		data.PermissionsSynced = types.BoolNull()
+	} else {
+		parent, err := client.Channel(channel.ParentID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to fetch category of channel", err.Error())
+			return
+		}
+
+		data.PermissionsSynced = types.BoolValue(utils.ArePermissionsSynced(channel, parent))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DiscordStageChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DiscordStageChannel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+
+	if _, err := client.ChannelDelete(data.ChannelID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+		resp.Diagnostics.AddError("Failed to delete channel", err.Error())
+		return
+	}
+}
+
+func (r *DiscordStageChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Both "<channel id>" and "<server id>:<channel id>" are accepted.
+	channelID := req.ID
+	if serverID, id, found := strings.Cut(req.ID, ":"); found {
+		if serverID == "" || id == "" {
+			resp.Diagnostics.AddError("error importing Discord channel", "invalid ID specified. Please specify the ID as \"channel_id\" or \"server_id:channel_id\"")
+			return
+		}
+		channelID = id
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	}
+	client := r.client.Session
+	channel, err := client.Channel(channelID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
+		return
+	}
+	if channel.ParentID == "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sync_perms_with_category"), false)...)
+	} else {
+		parent, err := client.Channel(channel.ParentID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to fetch category of channel", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sync_perms_with_category"), types.BoolValue(utils.ArePermissionsSynced(channel, parent)))...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelID)...)
+}
+
+func buildStageChannelParams(data *DiscordStageChannel) (discordgo.GuildChannelCreateData, error) {
+	if data.Type.ValueString() == "" {
+		data.Type = types.StringValue("stage")
+	}
+	channelType, okay := utils.GetDiscordChannelType(data.Type.ValueString())
+	if !okay {
+		return discordgo.GuildChannelCreateData{}, fmt.Errorf("invalid channel type: %s", data.Type.ValueString())
+	}
+	return discordgo.GuildChannelCreateData{
+		Name:      data.Name.ValueString(),
+		Position:  int(data.Position.ValueInt64()),
+		Type:      channelType,
+		Topic:     data.Topic.ValueString(),
+		Bitrate:   int(data.Bitrate.ValueInt64()),
+		UserLimit: int(data.UserLimit.ValueInt64()),
+		ParentID:  data.Category.ValueString(),
+	}, nil
+
+}
+
+func buildStageChannelModel(channel *discordgo.Channel, auditLogReason types.String, SyncPermsWithCategory types.Bool) (*DiscordStageChannel, error) {
+	channelType, okay := utils.GetTextChannelType(channel.Type)
+	if !okay {
+		return &DiscordStageChannel{}, fmt.Errorf("invalid channel type: %s", channelType)
+	}
+
+	return &DiscordStageChannel{
+		ID:                    types.StringValue(channel.ID),
+		ServerID:              types.StringValue(channel.GuildID),
+		ChannelID:             types.StringValue(channel.ID),
+		Type:                  types.StringValue(channelType),
+		Name:                  types.StringValue(channel.Name),
+		Position:              types.Int64Value(int64(channel.Position)),
+		AuditLogReason:        auditLogReason,
+		Category:              types.StringValue(channel.ParentID),
+		SyncPermsWithCategory: SyncPermsWithCategory,
+		Topic:                 types.StringValue(channel.Topic),
+		Bitrate:               types.Int64Value(int64(channel.Bitrate)),
+		UserLimit:             types.Int64Value(int64(channel.UserLimit)),
+	}, nil
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccResourceDiscordChannelStage(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_stage_channel.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordStageChannel(testServerID, "us-east"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "terraform-stage-channel"),
+					resource.TestCheckResourceAttr(name, "type", "stage"),
+					resource.TestCheckResourceAttr(name, "topic", "Weekly town hall"),
+					resource.TestCheckResourceAttr(name, "bitrate", "64000"),
+					resource.TestCheckResourceAttr(name, "user_limit", "500"),
+					resource.TestCheckResourceAttr(name, "rtc_region", "us-east"),
+					resource.TestCheckResourceAttrPair(name, "category", "discord_category_channel.example", "channel_id"),
+					resource.TestCheckResourceAttr(name, "sync_perms_with_category", "true"),
+					resource.TestCheckResourceAttrSet(name, "channel_id"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordStageChannel(testServerID, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(name, "rtc_region"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordStageChannel(serverID string, rtcRegion string) string {
+	region := "null"
+	if rtcRegion != "" {
+		region = fmt.Sprintf("%q", rtcRegion)
+	}
+	return fmt.Sprintf(`
+	resource "discord_category_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-stage-category"
+	}
+
+	resource "discord_stage_channel" "example" {
+	  server_id = "%[1]s"
+      name = "terraform-stage-channel"
+      topic = "Weekly town hall"
+      user_limit = 500
+      rtc_region = %[2]s
+      category = discord_category_channel.example.channel_id
+	}`, serverID, region)
+}
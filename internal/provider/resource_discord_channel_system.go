@@ -3,22 +3,21 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"strings"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DiscordSystemChannelResource{}
 var _ resource.ResourceWithImportState = &DiscordSystemChannelResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordSystemChannelResource{}
 
 func NewDiscordSystemChannelResource() resource.Resource {
-	return &DiscordEveryoneRoleResource{}
+	return &DiscordSystemChannelResource{}
 }
 
 type DiscordSystemChannelResource struct {
@@ -27,7 +26,8 @@ type DiscordSystemChannelResource struct {
 
 type DiscordSystemChannelResourceModel struct {
 	ServerID        types.String `tfsdk:"server_id"`
-	SystemChannelID types.String `tfsdk:"permissions"`
+	SystemChannelID types.String `tfsdk:"system_channel_id"`
+	AuditLogReason  types.String `tfsdk:"audit_log_reason"`
 }
 
 func (r *DiscordSystemChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -36,20 +36,15 @@ func (r *DiscordSystemChannelResource) Metadata(ctx context.Context, req resourc
 
 func (r *DiscordSystemChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Discord @everyone Role Resource",
+		MarkdownDescription: "Discord System Channel Resource",
 
 		Attributes: map[string]schema.Attribute{
-			"server_id": schema.StringAttribute{
-				MarkdownDescription: "The server ID",
-				Required:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
+			"server_id": utils.ServerIDAttribute(),
 			"system_channel_id": schema.StringAttribute{
 				MarkdownDescription: "Channel ID for system messages",
 				Required:            true,
 			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
 		},
 	}
 }
@@ -73,6 +68,13 @@ func (r *DiscordSystemChannelResource) Configure(ctx context.Context, req resour
 	r.client = client
 }
 
+func (r *DiscordSystemChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
 func (r *DiscordSystemChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *DiscordSystemChannelResourceModel
 
@@ -83,18 +85,9 @@ func (r *DiscordSystemChannelResource) Create(ctx context.Context, req resource.
 	}
 	client := r.client.Session
 	serverID := data.ServerID.ValueString()
-	server, err := client.Guild(serverID, discordgo.WithContext(ctx))
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to find server %s", serverID), err.Error())
-		return
-	}
-	systemChannelID := server.SystemChannelID
-	if systemChannelID != data.SystemChannelID.ValueString() {
-		systemChannelID = data.SystemChannelID.ValueString()
-	}
 	if _, err := client.GuildEdit(serverID, &discordgo.GuildParams{
-		SystemChannelID: systemChannelID,
-	}); err != nil {
+		SystemChannelID: data.SystemChannelID.ValueString(),
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update system channel id server %s", serverID), err.Error())
 		return
 	}
@@ -112,6 +105,10 @@ func (r *DiscordSystemChannelResource) Read(ctx context.Context, req resource.Re
 	client := r.client.Session
 	serverID := data.ServerID.ValueString()
 	server, err := client.Guild(serverID, discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "server", serverID)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Error fetching server %s", serverID), err.Error())
 		return
@@ -125,6 +122,10 @@ func (r *DiscordSystemChannelResource) Update(ctx context.Context, req resource.
 	var data *DiscordSystemChannelResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	client := r.client.Session
 	server, err := client.Guild(data.ServerID.ValueString(), discordgo.WithContext(ctx))
 	if err != nil {
@@ -135,35 +136,19 @@ func (r *DiscordSystemChannelResource) Update(ctx context.Context, req resource.
 	if data.SystemChannelID.ValueString() != server.SystemChannelID {
 		if _, err := client.GuildEdit(data.ServerID.ValueString(), &discordgo.GuildParams{
 			SystemChannelID: data.SystemChannelID.ValueString(),
-		}); err != nil {
+		}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
 			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update system channel id server %s", data.ServerID.ValueString()), err.Error())
 			return
 		}
 
 	}
-
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DiscordSystemChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DiscordSystemChannelResourceModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.AddError("Cannot delete @everyone role", "Cannot delete @everyone role. If you need to remove the resource then delete it from your state")
-
+	// A server always has a system channel setting, so the resource is only removed from the state.
 }
 
 func (r *DiscordSystemChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idparts := strings.Split(req.ID, "/")
-	if len(idparts) != 2 {
-		resp.Diagnostics.AddError("error importing Discord Message", "invalid ID specified. Please specify the ID as \"server_id/role_id\"")
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("server_id"), idparts[0],
-	)...)
+	resource.ImportStatePassthroughID(ctx, path.Root("server_id"), req, resp)
 }
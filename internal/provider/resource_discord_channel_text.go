@@ -1,5 +1,5 @@
 // Code generated by go generate in tools; DO NOT EDIT.
-// Generated at: 2025-02-01T21:25:19-05:00
+// Generated at: 2026-10-17T20:42:54Z
 
 package provider
 
@@ -18,11 +18,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DiscordTextChannelResource{}
 var _ resource.ResourceWithImportState = &DiscordTextChannelResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordTextChannelResource{}
 
 func NewDiscordTextChannelResource() resource.Resource {
 	return &DiscordTextChannelResource{}
@@ -44,14 +46,11 @@ func (r *DiscordTextChannelResource) Schema(ctx context.Context, req resource.Sc
 			"id": schema.StringAttribute{
 				Description: "The channel ID",
 				Computed:    true,
-			},
-			"server_id": schema.StringAttribute{
-				Description: "The server ID",
-				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"server_id": utils.ServerIDAttribute(),
 			"name": schema.StringAttribute{
 				Description: "The channel name",
 				Required:    true,
@@ -59,6 +58,9 @@ func (r *DiscordTextChannelResource) Schema(ctx context.Context, req resource.Sc
 			"channel_id": schema.StringAttribute{
 				Description: "The channel ID",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"type": schema.StringAttribute{
 				Description:        "The channel type",
@@ -66,6 +68,7 @@ func (r *DiscordTextChannelResource) Schema(ctx context.Context, req resource.Sc
 				DeprecationMessage: "This field is deprecated. Type is now inferred from the resource name.",
 				Computed:           true,
 			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
 			"position": schema.Int64Attribute{
 				Description: "Sorting position of the channel",
 				Optional:    true,
@@ -122,6 +125,13 @@ func (r *DiscordTextChannelResource) Configure(ctx context.Context, req resource
 	r.client = client
 }
 
+func (r *DiscordTextChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
 type DiscordTextChannel struct {
 	ID                    types.String `tfsdk:"id"`
 	ServerID              types.String `tfsdk:"server_id"`
@@ -129,6 +139,7 @@ type DiscordTextChannel struct {
 	Type                  types.String `tfsdk:"type"`
 	Name                  types.String `tfsdk:"name"`
 	Position              types.Int64  `tfsdk:"position"`
+	AuditLogReason        types.String `tfsdk:"audit_log_reason"`
 	SyncPermsWithCategory types.Bool   `tfsdk:"sync_perms_with_category"`
 	Category              types.String `tfsdk:"category"`
 	PermissionsSynced     types.Bool   `tfsdk:"permissions_synced"`
@@ -150,12 +161,12 @@ func (r *DiscordTextChannelResource) Create(ctx context.Context, req resource.Cr
 		resp.Diagnostics.AddError("Failed to build channel params", err.Error())
 		return
 	}
-	channel, err := client.GuildChannelCreateComplex(data.ServerID.ValueString(), channelParams, discordgo.WithContext(ctx))
+	channel, err := client.GuildChannelCreateComplex(data.ServerID.ValueString(), channelParams, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create a channel", err.Error())
 		return
 	}
-	data, err = buildTextChannelModel(channel, data.SyncPermsWithCategory)
+	data, err = buildTextChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
 		return
@@ -174,12 +185,16 @@ func (r *DiscordTextChannelResource) Read(ctx context.Context, req resource.Read
 	}
 	client := r.client.Session
 	channel, err := client.Channel(data.ChannelID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "channel", data.ChannelID.ValueString())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
 		return
 	}
 
-	data, err = buildTextChannelModel(channel, data.SyncPermsWithCategory)
+	data, err = buildTextChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
 		return
@@ -234,26 +249,27 @@ func (r *DiscordTextChannelResource) Update(ctx context.Context, req resource.Up
 		}
 
 		if !utils.ArePermissionsSynced(channel, parent) {
-			if err := utils.SyncChannelPermissions(client, ctx, parent, channel); err != nil {
+			if err := utils.SyncChannelPermissions(client, ctx, parent, channel, utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
 				resp.Diagnostics.AddError("Failed to sync permissions with category", err.Error())
 				return
 			}
 		}
 	}
 
-	channel, err = client.ChannelEditComplex(data.ChannelID.ValueString(), &discordgo.ChannelEdit{
+	channelEdit := discordgo.ChannelEdit{
 		Name:     channelParams.Name,
 		Position: &channelParams.Position,
 		Topic:    channelParams.Topic,
 		NSFW:     &channelParams.NSFW,
 		ParentID: channelParams.ParentID,
-	}, discordgo.WithContext(ctx))
+	}
+	channel, err = client.ChannelEditComplex(data.ChannelID.ValueString(), &channelEdit, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update channel", err.Error())
 		return
 	}
 
-	data, err = buildTextChannelModel(channel, data.SyncPermsWithCategory)
+	data, err = buildTextChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
 		return
@@ -283,15 +299,25 @@ func (r *DiscordTextChannelResource) Delete(ctx context.Context, req resource.De
 	}
 	client := r.client.Session
 
-	if _, err := client.ChannelDelete(data.ChannelID.ValueString(), discordgo.WithContext(ctx)); err != nil {
+	if _, err := client.ChannelDelete(data.ChannelID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
 		resp.Diagnostics.AddError("Failed to delete channel", err.Error())
 		return
 	}
 }
 
 func (r *DiscordTextChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Both "<channel id>" and "<server id>:<channel id>" are accepted.
+	channelID := req.ID
+	if serverID, id, found := strings.Cut(req.ID, ":"); found {
+		if serverID == "" || id == "" {
+			resp.Diagnostics.AddError("error importing Discord channel", "invalid ID specified. Please specify the ID as \"channel_id\" or \"server_id:channel_id\"")
+			return
+		}
+		channelID = id
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	}
 	client := r.client.Session
-	channel, err := client.Channel(req.ID)
+	channel, err := client.Channel(channelID)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
 		return
@@ -306,7 +332,7 @@ func (r *DiscordTextChannelResource) ImportState(ctx context.Context, req resour
 		}
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sync_perms_with_category"), types.BoolValue(utils.ArePermissionsSynced(channel, parent)))...)
 	}
-	resource.ImportStatePassthroughID(ctx, path.Root("channel_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelID)...)
 }
 
 func buildTextChannelParams(data *DiscordTextChannel) (discordgo.GuildChannelCreateData, error) {
@@ -328,7 +354,7 @@ func buildTextChannelParams(data *DiscordTextChannel) (discordgo.GuildChannelCre
 
 }
 
-func buildTextChannelModel(channel *discordgo.Channel, SyncPermsWithCategory types.Bool) (*DiscordTextChannel, error) {
+func buildTextChannelModel(channel *discordgo.Channel, auditLogReason types.String, SyncPermsWithCategory types.Bool) (*DiscordTextChannel, error) {
 	channelType, okay := utils.GetTextChannelType(channel.Type)
 	if !okay {
 		return &DiscordTextChannel{}, fmt.Errorf("invalid channel type: %s", channelType)
@@ -341,6 +367,7 @@ func buildTextChannelModel(channel *discordgo.Channel, SyncPermsWithCategory typ
 		Type:                  types.StringValue(channelType),
 		Name:                  types.StringValue(channel.Name),
 		Position:              types.Int64Value(int64(channel.Position)),
+		AuditLogReason:        auditLogReason,
 		Category:              types.StringValue(channel.ParentID),
 		SyncPermsWithCategory: SyncPermsWithCategory,
 		Topic:                 types.StringValue(channel.Topic),
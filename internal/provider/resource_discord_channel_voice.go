@@ -1,5 +1,5 @@
 // Code generated by go generate in tools; DO NOT EDIT.
-// Generated at: 2025-02-01T21:25:19-05:00
+// Generated at: 2026-10-17T20:42:54Z
 
 package provider
 
@@ -18,11 +18,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DiscordTextChannelResource{}
 var _ resource.ResourceWithImportState = &DiscordTextChannelResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordVoiceChannelResource{}
 
 func NewDiscordVoiceChannelResource() resource.Resource {
 	return &DiscordVoiceChannelResource{}
@@ -44,14 +46,11 @@ func (r *DiscordVoiceChannelResource) Schema(ctx context.Context, req resource.S
 			"id": schema.StringAttribute{
 				Description: "The channel ID",
 				Computed:    true,
-			},
-			"server_id": schema.StringAttribute{
-				Description: "The server ID",
-				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"server_id": utils.ServerIDAttribute(),
 			"name": schema.StringAttribute{
 				Description: "The channel name",
 				Required:    true,
@@ -59,6 +58,9 @@ func (r *DiscordVoiceChannelResource) Schema(ctx context.Context, req resource.S
 			"channel_id": schema.StringAttribute{
 				Description: "The channel ID",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"type": schema.StringAttribute{
 				Description:        "The channel type",
@@ -66,6 +68,7 @@ func (r *DiscordVoiceChannelResource) Schema(ctx context.Context, req resource.S
 				DeprecationMessage: "This field is deprecated. Type is now inferred from the resource name.",
 				Computed:           true,
 			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
 			"position": schema.Int64Attribute{
 				Description: "Sorting position of the channel",
 				Optional:    true,
@@ -100,6 +103,10 @@ func (r *DiscordVoiceChannelResource) Schema(ctx context.Context, req resource.S
 				Description: "The user limit of the channel",
 				Optional:    true,
 			},
+			"rtc_region": schema.StringAttribute{
+				Description: "The voice region ID of the channel. Automatic when not set",
+				Optional:    true,
+			},
 			"nsfw": schema.BoolAttribute{
 				Description: "Whether the channel is NSFW",
 				Optional:    true,
@@ -128,6 +135,13 @@ func (r *DiscordVoiceChannelResource) Configure(ctx context.Context, req resourc
 	r.client = client
 }
 
+func (r *DiscordVoiceChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
 type DiscordVoiceChannel struct {
 	ID                    types.String `tfsdk:"id"`
 	ServerID              types.String `tfsdk:"server_id"`
@@ -135,12 +149,14 @@ type DiscordVoiceChannel struct {
 	Type                  types.String `tfsdk:"type"`
 	Name                  types.String `tfsdk:"name"`
 	Position              types.Int64  `tfsdk:"position"`
+	AuditLogReason        types.String `tfsdk:"audit_log_reason"`
 	SyncPermsWithCategory types.Bool   `tfsdk:"sync_perms_with_category"`
 	Category              types.String `tfsdk:"category"`
 	PermissionsSynced     types.Bool   `tfsdk:"permissions_synced"`
 	NSFW                  types.Bool   `tfsdk:"nsfw"`
 	Bitrate               types.Int64  `tfsdk:"bitrate"`
 	UserLimit             types.Int64  `tfsdk:"user_limit"`
+	RTCRegion             types.String `tfsdk:"rtc_region"`
 }
 
 func (r *DiscordVoiceChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -157,16 +173,21 @@ func (r *DiscordVoiceChannelResource) Create(ctx context.Context, req resource.C
 		resp.Diagnostics.AddError("Failed to build channel params", err.Error())
 		return
 	}
-	channel, err := client.GuildChannelCreateComplex(data.ServerID.ValueString(), channelParams, discordgo.WithContext(ctx))
+	voice, err := utils.VoiceChannelCreateComplex(client, data.ServerID.ValueString(), utils.VoiceChannelCreateData{
+		GuildChannelCreateData: channelParams,
+		RTCRegion:              data.RTCRegion.ValueStringPointer(),
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create a channel", err.Error())
 		return
 	}
-	data, err = buildVoiceChannelModel(channel, data.SyncPermsWithCategory)
+	channel := voice.Channel
+	data, err = buildVoiceChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
 		return
 	}
+	data.RTCRegion = types.StringPointerValue(voice.RTCRegion)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -180,17 +201,23 @@ func (r *DiscordVoiceChannelResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 	client := r.client.Session
-	channel, err := client.Channel(data.ChannelID.ValueString(), discordgo.WithContext(ctx))
+	voice, err := utils.GetVoiceChannel(client, data.ChannelID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "channel", data.ChannelID.ValueString())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
 		return
 	}
+	channel := voice.Channel
 
-	data, err = buildVoiceChannelModel(channel, data.SyncPermsWithCategory)
+	data, err = buildVoiceChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
 		return
 	}
+	data.RTCRegion = types.StringPointerValue(voice.RTCRegion)
 	if channel.ParentID == "" {
 		data.PermissionsSynced = types.BoolNull()
 	} else {
@@ -241,31 +268,37 @@ func (r *DiscordVoiceChannelResource) Update(ctx context.Context, req resource.U
 		}
 
 		if !utils.ArePermissionsSynced(channel, parent) {
-			if err := utils.SyncChannelPermissions(client, ctx, parent, channel); err != nil {
+			if err := utils.SyncChannelPermissions(client, ctx, parent, channel, utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
 				resp.Diagnostics.AddError("Failed to sync permissions with category", err.Error())
 				return
 			}
 		}
 	}
 
-	channel, err = client.ChannelEditComplex(data.ChannelID.ValueString(), &discordgo.ChannelEdit{
+	channelEdit := discordgo.ChannelEdit{
 		Name:      channelParams.Name,
 		Position:  &channelParams.Position,
 		NSFW:      &channelParams.NSFW,
 		Bitrate:   channelParams.Bitrate,
 		UserLimit: channelParams.UserLimit,
 		ParentID:  channelParams.ParentID,
-	}, discordgo.WithContext(ctx))
+	}
+	voice, err := utils.VoiceChannelEditComplex(client, data.ChannelID.ValueString(), &utils.VoiceChannelEdit{
+		ChannelEdit: channelEdit,
+		RTCRegion:   data.RTCRegion.ValueStringPointer(),
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update channel", err.Error())
 		return
 	}
+	channel = voice.Channel
 
-	data, err = buildVoiceChannelModel(channel, data.SyncPermsWithCategory)
+	data, err = buildVoiceChannelModel(channel, data.AuditLogReason, data.SyncPermsWithCategory)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to build channel model", err.Error())
 		return
 	}
+	data.RTCRegion = types.StringPointerValue(voice.RTCRegion)
 	if channel.ParentID == "" {
 		data.PermissionsSynced = types.BoolNull()
 	} else {
@@ -291,15 +324,25 @@ func (r *DiscordVoiceChannelResource) Delete(ctx context.Context, req resource.D
 	}
 	client := r.client.Session
 
-	if _, err := client.ChannelDelete(data.ChannelID.ValueString(), discordgo.WithContext(ctx)); err != nil {
+	if _, err := client.ChannelDelete(data.ChannelID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
 		resp.Diagnostics.AddError("Failed to delete channel", err.Error())
 		return
 	}
 }
 
 func (r *DiscordVoiceChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Both "<channel id>" and "<server id>:<channel id>" are accepted.
+	channelID := req.ID
+	if serverID, id, found := strings.Cut(req.ID, ":"); found {
+		if serverID == "" || id == "" {
+			resp.Diagnostics.AddError("error importing Discord channel", "invalid ID specified. Please specify the ID as \"channel_id\" or \"server_id:channel_id\"")
+			return
+		}
+		channelID = id
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	}
 	client := r.client.Session
-	channel, err := client.Channel(req.ID)
+	channel, err := client.Channel(channelID)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
 		return
@@ -314,7 +357,7 @@ func (r *DiscordVoiceChannelResource) ImportState(ctx context.Context, req resou
 		}
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sync_perms_with_category"), types.BoolValue(utils.ArePermissionsSynced(channel, parent)))...)
 	}
-	resource.ImportStatePassthroughID(ctx, path.Root("channel_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelID)...)
 }
 
 func buildVoiceChannelParams(data *DiscordVoiceChannel) (discordgo.GuildChannelCreateData, error) {
@@ -337,7 +380,7 @@ func buildVoiceChannelParams(data *DiscordVoiceChannel) (discordgo.GuildChannelC
 
 }
 
-func buildVoiceChannelModel(channel *discordgo.Channel, SyncPermsWithCategory types.Bool) (*DiscordVoiceChannel, error) {
+func buildVoiceChannelModel(channel *discordgo.Channel, auditLogReason types.String, SyncPermsWithCategory types.Bool) (*DiscordVoiceChannel, error) {
 	channelType, okay := utils.GetTextChannelType(channel.Type)
 	if !okay {
 		return &DiscordVoiceChannel{}, fmt.Errorf("invalid channel type: %s", channelType)
@@ -350,6 +393,7 @@ func buildVoiceChannelModel(channel *discordgo.Channel, SyncPermsWithCategory ty
 		Type:                  types.StringValue(channelType),
 		Name:                  types.StringValue(channel.Name),
 		Position:              types.Int64Value(int64(channel.Position)),
+		AuditLogReason:        auditLogReason,
 		Category:              types.StringValue(channel.ParentID),
 		SyncPermsWithCategory: SyncPermsWithCategory,
 		NSFW:                  types.BoolValue(channel.NSFW),
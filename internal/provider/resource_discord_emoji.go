@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// maxEmojiSize is the largest emoji image Discord accepts.
+const maxEmojiSize = 256 * 1024
+
+// emojiFormats are the image formats Discord accepts for emojis.
+var emojiFormats = []string{"image/png", "image/jpeg", "image/gif", "image/webp"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordEmojiResource{}
+var _ resource.ResourceWithImportState = &DiscordEmojiResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordEmojiResource{}
+
+func NewDiscordEmojiResource() resource.Resource {
+	return &DiscordEmojiResource{}
+}
+
+type DiscordEmojiResource struct {
+	client *Context
+}
+
+type DiscordEmojiModel struct {
+	ID             types.String `tfsdk:"id"`
+	ServerID       types.String `tfsdk:"server_id"`
+	Name           types.String `tfsdk:"name"`
+	Roles          types.Set    `tfsdk:"roles"`
+	ImageFile      types.String `tfsdk:"image_file"`
+	ImageDataURI   types.String `tfsdk:"image_data_uri"`
+	ImageURL       types.String `tfsdk:"image_url"`
+	ImageHash      types.String `tfsdk:"image_hash"`
+	Animated       types.Bool   `tfsdk:"animated"`
+	AuditLogReason types.String `tfsdk:"audit_log_reason"`
+}
+
+func (r *DiscordEmojiResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_emoji"
+}
+
+func (r *DiscordEmojiResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	imageSources := []path.Expression{
+		path.MatchRoot("image_file"),
+		path.MatchRoot("image_data_uri"),
+		path.MatchRoot("image_url"),
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Emoji Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the emoji",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": utils.ServerIDAttribute(),
+			"name": schema.StringAttribute{
+				Description: "The name of the emoji, used as `:name:` in messages",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9_]{2,32}$`), "must be 2 to 32 letters, numbers or underscores"),
+				},
+			},
+			"roles": schema.SetAttribute{
+				Description: "The IDs of the roles allowed to use the emoji. Everyone can use it when empty",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"image_file": schema.StringAttribute{
+				Description: "The path of a PNG, JPEG, GIF or WebP image of at most 256 KiB",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(imageSources...),
+				},
+			},
+			"image_data_uri": schema.StringAttribute{
+				Description: "The image as a data URI, such as the one of the `discord_local_image` data source",
+				Optional:    true,
+			},
+			"image_url": schema.StringAttribute{
+				Description: "The URL to download the image from",
+				Optional:    true,
+			},
+			"image_hash": schema.StringAttribute{
+				Description: "The SHA-256 hash of the image. The emoji is replaced when the image changes, as Discord cannot change the image of an emoji",
+				Computed:    true,
+			},
+			"animated": schema.BoolAttribute{
+				Description: "Whether the emoji is animated",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
+		},
+	}
+}
+
+func (r *DiscordEmojiResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan hashes the image so that a changed image replaces the emoji while an unchanged one is not uploaded
+// again.
+func (r *DiscordEmojiResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan DiscordEmojiModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The image is only known at apply time when it comes from another resource.
+	known := !plan.ImageFile.IsUnknown() && !plan.ImageDataURI.IsUnknown() && !plan.ImageURL.IsUnknown()
+	utils.ModifyPlanImageHash(ctx, req, resp, path.Root("image_hash"), known, func() ([]byte, diag.Diagnostics) {
+		image, _, diags := loadEmojiImage(ctx, r.client.DownloadClient, plan)
+		return image, diags
+	})
+}
+
+func (r *DiscordEmojiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordEmojiModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	image, format, diags := loadEmojiImage(ctx, r.client.DownloadClient, data)
+	resp.Diagnostics.Append(diags...)
+	var roles []string
+	resp.Diagnostics.Append(data.Roles.ElementsAs(ctx, &roles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	emoji, err := client.GuildEmojiCreate(serverID, &discordgo.EmojiParams{
+		Name:  data.Name.ValueString(),
+		Image: utils.ImageDataURI(format, image),
+		Roles: roles,
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create emoji", err.Error())
+		return
+	}
+	data.ImageHash = types.StringValue(utils.ImageHash(image))
+
+	model, diags := buildEmojiModel(ctx, serverID, emoji, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordEmojiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordEmojiModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	emoji, err := r.client.Session.GuildEmoji(serverID, data.ID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "emoji", data.ID.ValueString())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get emoji %s", data.ID.ValueString()), err.Error())
+		return
+	}
+
+	model, diags := buildEmojiModel(ctx, serverID, emoji, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordEmojiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DiscordEmojiModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var roles []string
+	resp.Diagnostics.Append(data.Roles.ElementsAs(ctx, &roles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	// A changed image replaces the emoji, so only the name and roles can change here.
+	emoji, err := utils.EmojiEditComplex(r.client.Session, serverID, data.ID.ValueString(), &utils.EmojiEdit{
+		Name:  data.Name.ValueString(),
+		Roles: roles,
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update emoji %s", data.ID.ValueString()), err.Error())
+		return
+	}
+
+	model, diags := buildEmojiModel(ctx, serverID, emoji, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordEmojiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordEmojiModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.Session.GuildEmojiDelete(data.ServerID.ValueString(), data.ID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete emoji %s", data.ID.ValueString()), err.Error())
+		return
+	}
+}
+
+func (r *DiscordEmojiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverID, emojiID, ok := strings.Cut(req.ID, ":")
+	if !ok || serverID == "" || emojiID == "" {
+		resp.Diagnostics.AddError("error importing Discord Emoji", "invalid ID specified. Please specify the ID as \"server_id:emoji_id\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), emojiID)...)
+}
+
+// loadEmojiImage reads the configured image and checks it against the Discord emoji limits, so that a bad image
+// fails the plan instead of the API call.
+func loadEmojiImage(ctx context.Context, client *http.Client, data DiscordEmojiModel) ([]byte, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	image, err := utils.LoadImage(ctx, client, utils.ImageSource{
+		File:    data.ImageFile.ValueString(),
+		DataURI: data.ImageDataURI.ValueString(),
+		URL:     data.ImageURL.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Failed to read emoji image", err.Error())
+		return nil, "", diags
+	}
+	format, err := utils.ValidateImage(image, maxEmojiSize, emojiFormats...)
+	if err != nil {
+		diags.AddError("Invalid emoji image", err.Error())
+		return nil, "", diags
+	}
+
+	return image, format, diags
+}
+
+func buildEmojiModel(ctx context.Context, serverID string, emoji *discordgo.Emoji, data DiscordEmojiModel) (*DiscordEmojiModel, diag.Diagnostics) {
+	roles, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, emoji.Roles...))
+
+	return &DiscordEmojiModel{
+		ID:             types.StringValue(emoji.ID),
+		ServerID:       types.StringValue(serverID),
+		Name:           types.StringValue(emoji.Name),
+		Roles:          roles,
+		ImageFile:      data.ImageFile,
+		ImageDataURI:   data.ImageDataURI,
+		ImageURL:       data.ImageURL,
+		ImageHash:      data.ImageHash,
+		Animated:       types.BoolValue(emoji.Animated),
+		AuditLogReason: data.AuditLogReason,
+	}, diags
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceDiscordEmoji(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testImageURL := os.Getenv("DISCORD_TEST_AVATAR_URL")
+	if testServerID == "" || testImageURL == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID and DISCORD_TEST_AVATAR_URL envvars must be set for acceptance tests")
+	}
+	dir := t.TempDir()
+	file := filepath.Join(dir, "emoji.png")
+	green := testAccPNG(t, 32, color.RGBA{G: 0xff, A: 0xff})
+	blue := testAccPNG(t, 32, color.RGBA{B: 0xff, A: 0xff})
+	writeImage := func(data []byte) func() {
+		return func() {
+			if err := os.WriteFile(file, data, 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	writeImage(green)()
+	large := filepath.Join(dir, "large.png")
+	if err := os.WriteFile(large, append(append([]byte{}, green...), make([]byte, 256*1024)...), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	name := "discord_emoji.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceDiscordEmoji(testServerID, file, "terraform_large", fmt.Sprintf("image_file = %q", large), false),
+				ExpectError: regexp.MustCompile("larger than the 256 KiB limit"),
+			},
+			{
+				Config:      testAccResourceDiscordEmoji(testServerID, file, "terraform_text", `image_file = "provider.go"`, false),
+				ExpectError: regexp.MustCompile("Invalid emoji image"),
+			},
+			{
+				Config: testAccResourceDiscordEmoji(testServerID, file, "terraform_emoji", fmt.Sprintf("image_file = %q", file), true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "terraform_emoji"),
+					resource.TestCheckResourceAttr(name, "roles.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "roles.0", "discord_role.example", "id"),
+					resource.TestCheckResourceAttr(name, "image_hash", utils.ImageHash(green)),
+					resource.TestCheckResourceAttr(name, "animated", "false"),
+				),
+			},
+			{
+				// The same image from a data URI is not uploaded again.
+				Config: testAccResourceDiscordEmoji(testServerID, file, "terraform_emoji", "image_data_uri = data.discord_local_image.example.data_uri", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "roles.#", "0"),
+					resource.TestCheckResourceAttr(name, "image_hash", utils.ImageHash(green)),
+				),
+			},
+			{
+				PreConfig: writeImage(blue),
+				Config:    testAccResourceDiscordEmoji(testServerID, file, "terraform_emoji", fmt.Sprintf("image_file = %q", file), false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(name, "image_hash", utils.ImageHash(blue)),
+			},
+			{
+				Config: testAccResourceDiscordEmoji(testServerID, file, "terraform_emoji_url", fmt.Sprintf("image_url = %q", testImageURL), false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "terraform_emoji_url"),
+					resource.TestCheckResourceAttrSet(name, "image_hash"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s:", testServerID),
+				ImportStateVerify:   true,
+				// The image source and its hash only exist in the configuration.
+				ImportStateVerifyIgnore: []string{"audit_log_reason", "image_url", "image_hash"},
+			},
+		},
+	})
+}
+
+// testAccPNG returns a square PNG of a single color.
+func testAccPNG(t *testing.T, size int, c color.Color) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func testAccResourceDiscordEmoji(serverID string, file string, name string, image string, restricted bool) string {
+	roles := "[]"
+	if restricted {
+		roles = "[discord_role.example.id]"
+	}
+
+	return fmt.Sprintf(`
+	data "discord_local_image" "example" {
+	  file = "%[5]s"
+	}
+
+	resource "discord_role" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-emoji-role"
+	  color = 0
+	  permissions = 0
+	}
+
+	resource "discord_emoji" "example" {
+	  server_id = "%[1]s"
+	  name = "%[2]s"
+	  %[3]s
+	  roles = %[4]s
+	  audit_log_reason = "Managed by Terraform"
+	}`, serverID, name, image, roles, file)
+}
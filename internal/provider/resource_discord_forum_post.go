@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordForumPostResource{}
+var _ resource.ResourceWithImportState = &DiscordForumPostResource{}
+
+func NewDiscordForumPostResource() resource.Resource {
+	return &DiscordForumPostResource{}
+}
+
+type DiscordForumPostResource struct {
+	client *Context
+}
+
+type DiscordForumPostModel struct {
+	ID                  types.String               `tfsdk:"id"`
+	ServerID            types.String               `tfsdk:"server_id"`
+	ChannelID           types.String               `tfsdk:"channel_id"`
+	Name                types.String               `tfsdk:"name"`
+	Content             types.String               `tfsdk:"content"`
+	Embed               []DiscordMessageEmbedModel `tfsdk:"embed"`
+	AppliedTags         types.Set                  `tfsdk:"applied_tags"`
+	Pinned              types.Bool                 `tfsdk:"pinned"`
+	Archived            types.Bool                 `tfsdk:"archived"`
+	Locked              types.Bool                 `tfsdk:"locked"`
+	AutoArchiveDuration types.Int64                `tfsdk:"auto_archive_duration"`
+
+	AuditLogReason types.String `tfsdk:"audit_log_reason"`
+}
+
+func (r *DiscordForumPostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forum_post"
+}
+
+func (r *DiscordForumPostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Forum Post Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The post ID. The same as the ID of its starter message",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Description: "The server ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the forum or media channel the post is in",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The post title",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "The content of the starter message",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2000),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("content"), path.MatchRoot("embed")),
+				},
+			},
+			"applied_tags": schema.SetAttribute{
+				Description: "The IDs of the forum tags applied to the post",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(5),
+				},
+			},
+			"pinned": schema.BoolAttribute{
+				Description: "Whether the post is pinned to the top of the channel",
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+				Computed:    true,
+			},
+			"archived": schema.BoolAttribute{
+				Description: "Whether the post is archived",
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+				Computed:    true,
+			},
+			"locked": schema.BoolAttribute{
+				Description: "Whether the post is locked. Only members with the Manage Threads permission can unarchive a locked post",
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+				Computed:    true,
+			},
+			"auto_archive_duration": schema.Int64Attribute{
+				Description: "The duration in minutes after which the post is archived when there is no activity. One of `60`, `1440`, `4320` or `10080`. Defaults to the channel `default_auto_archive_duration`",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(60, 1440, 4320, 10080),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"embed": messageEmbedBlock(),
+		},
+	}
+}
+
+func (r *DiscordForumPostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DiscordForumPostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordForumPostModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+
+	var appliedTags []string
+	resp.Diagnostics.Append(data.AppliedTags.ElementsAs(ctx, &appliedTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	thread, err := client.ForumThreadStartComplex(data.ChannelID.ValueString(), &discordgo.ThreadStart{
+		Name:                data.Name.ValueString(),
+		AutoArchiveDuration: int(data.AutoArchiveDuration.ValueInt64()),
+		AppliedTags:         appliedTags,
+	}, &discordgo.MessageSend{
+		Content: data.Content.ValueString(),
+		Embeds:  buildEmbedMessages(data.Embed),
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create forum post", err.Error())
+		return
+	}
+
+	// Posts cannot be started pinned, archived or locked.
+	if data.Pinned.ValueBool() || data.Archived.ValueBool() || data.Locked.ValueBool() {
+		threadID := thread.ID
+		thread, err = client.ChannelEditComplex(threadID, &discordgo.ChannelEdit{
+			Flags:    forumPostFlags(thread.Flags, data.Pinned.ValueBool()),
+			Archived: data.Archived.ValueBoolPointer(),
+			Locked:   data.Locked.ValueBoolPointer(),
+		}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update forum post %s", threadID), err.Error())
+			return
+		}
+	}
+
+	message, err := client.ChannelMessage(thread.ID, thread.ID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get starter message of forum post %s", thread.ID), err.Error())
+		return
+	}
+
+	model, diags := buildForumPostModel(ctx, thread, message, data.AuditLogReason)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordForumPostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordForumPostModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	thread, err := client.Channel(data.ID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "forum post", data.ID.ValueString())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get forum post %s", data.ID.ValueString()), err.Error())
+		return
+	}
+	// A post whose starter message was deleted cannot be edited any more, so it is created again.
+	message, err := client.ChannelMessage(thread.ID, thread.ID, discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "forum post starter message", thread.ID)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get starter message of forum post %s", thread.ID), err.Error())
+		return
+	}
+
+	model, diags := buildForumPostModel(ctx, thread, message, data.AuditLogReason)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordForumPostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DiscordForumPostModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	threadID := state.ID.ValueString()
+
+	// Archived posts can only be unarchived, so unarchive the post before editing anything else. It is archived again
+	// below when the plan says so.
+	if state.Archived.ValueBool() {
+		archived := false
+		if _, err := client.ChannelEditComplex(threadID, &discordgo.ChannelEdit{Archived: &archived}, discordgo.WithContext(ctx), utils.WithAuditLogReason(plan.AuditLogReason)); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to unarchive forum post %s", threadID), err.Error())
+			return
+		}
+	}
+
+	var planEmbed, stateEmbed types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("embed"), &planEmbed)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("embed"), &stateEmbed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The starter message is edited in place so the post keeps its ID and replies.
+	if !plan.Content.Equal(state.Content) || !planEmbed.Equal(stateEmbed) {
+		content := plan.Content.ValueString()
+		embeds := buildEmbedMessages(plan.Embed)
+		if _, err := client.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			Channel: threadID,
+			ID:      threadID,
+			Content: &content,
+			Embeds:  &embeds,
+		}, discordgo.WithContext(ctx)); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update starter message of forum post %s", threadID), err.Error())
+			return
+		}
+	}
+
+	appliedTags := []string{}
+	resp.Diagnostics.Append(plan.AppliedTags.ElementsAs(ctx, &appliedTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	current, err := client.Channel(threadID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get forum post %s", threadID), err.Error())
+		return
+	}
+	thread, err := client.ChannelEditComplex(threadID, &discordgo.ChannelEdit{
+		Name:                plan.Name.ValueString(),
+		AutoArchiveDuration: int(plan.AutoArchiveDuration.ValueInt64()),
+		AppliedTags:         &appliedTags,
+		Flags:               forumPostFlags(current.Flags, plan.Pinned.ValueBool()),
+		Locked:              plan.Locked.ValueBoolPointer(),
+		Archived:            plan.Archived.ValueBoolPointer(),
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(plan.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update forum post %s", threadID), err.Error())
+		return
+	}
+	message, err := client.ChannelMessage(threadID, threadID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get starter message of forum post %s", threadID), err.Error())
+		return
+	}
+
+	model, diags := buildForumPostModel(ctx, thread, message, plan.AuditLogReason)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordForumPostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordForumPostModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	if _, err := client.ChannelDelete(data.ID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete forum post %s", data.ID.ValueString()), err.Error())
+		return
+	}
+}
+
+func (r *DiscordForumPostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// forumPostFlags sets or clears the pinned flag while keeping the other flags of the post.
+func forumPostFlags(flags discordgo.ChannelFlags, pinned bool) *discordgo.ChannelFlags {
+	if pinned {
+		flags |= discordgo.ChannelFlagPinned
+	} else {
+		flags &^= discordgo.ChannelFlagPinned
+	}
+
+	return &flags
+}
+
+func buildForumPostModel(ctx context.Context, thread *discordgo.Channel, message *discordgo.Message, auditLogReason types.String) (*DiscordForumPostModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if thread.ThreadMetadata == nil {
+		diags.AddError("Failed to build forum post model", fmt.Sprintf("channel %s is not a forum post", thread.ID))
+		return nil, diags
+	}
+	appliedTags, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, thread.AppliedTags...))
+
+	return &DiscordForumPostModel{
+		ID:                  types.StringValue(thread.ID),
+		ServerID:            types.StringValue(thread.GuildID),
+		ChannelID:           types.StringValue(thread.ParentID),
+		Name:                types.StringValue(thread.Name),
+		Content:             utils.StringValueOrNull(message.Content),
+		Embed:               unbuildEmbedMessages(message.Embeds),
+		AppliedTags:         appliedTags,
+		Pinned:              types.BoolValue(thread.Flags&discordgo.ChannelFlagPinned != 0),
+		Archived:            types.BoolValue(thread.ThreadMetadata.Archived),
+		Locked:              types.BoolValue(thread.ThreadMetadata.Locked),
+		AutoArchiveDuration: types.Int64Value(int64(thread.ThreadMetadata.AutoArchiveDuration)),
+		AuditLogReason:      auditLogReason,
+	}, diags
+}
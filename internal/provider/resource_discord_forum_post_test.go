@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccResourceDiscordForumPost(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_forum_post.example"
+	var postID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordForumPost(testServerID, "How do I import a channel?", 0, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "channel_id", "discord_forum_channel.example", "id"),
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "terraform-question"),
+					resource.TestCheckResourceAttr(name, "content", "How do I import a channel?"),
+					resource.TestCheckResourceAttr(name, "embed.#", "1"),
+					resource.TestCheckResourceAttr(name, "embed.0.title", "Details"),
+					resource.TestCheckResourceAttr(name, "applied_tags.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(name, "applied_tags.*", "discord_forum_channel.example", "available_tag.0.id"),
+					resource.TestCheckResourceAttr(name, "pinned", "true"),
+					resource.TestCheckResourceAttr(name, "archived", "false"),
+					resource.TestCheckResourceAttr(name, "locked", "false"),
+					resource.TestCheckResourceAttr(name, "auto_archive_duration", "4320"),
+					func(s *terraform.State) error {
+						postID = s.RootModule().Resources[name].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Changing the content edits the starter message instead of creating a new post.
+				Config: testAccResourceDiscordForumPost(testServerID, "How do I import a forum channel?", 1, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "content", "How do I import a forum channel?"),
+					resource.TestCheckTypeSetElemAttrPair(name, "applied_tags.*", "discord_forum_channel.example", "available_tag.1.id"),
+					resource.TestCheckResourceAttr(name, "pinned", "false"),
+					resource.TestCheckResourceAttr(name, "archived", "true"),
+					resource.TestCheckResourceAttr(name, "locked", "true"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[name].Primary.ID; id != postID {
+							return fmt.Errorf("forum post was recreated: %s != %s", id, postID)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// A post whose starter message was deleted outside Terraform is created again.
+				PreConfig: func() {
+					if err := testAccClient(t).Session.ChannelMessageDelete(postID, postID); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccResourceDiscordForumPost(testServerID, "How do I import a forum channel?", 1, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "content", "How do I import a forum channel?"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[name].Primary.ID; id == postID {
+							return fmt.Errorf("forum post was not recreated: %s", id)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"audit_log_reason"},
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordForumPost(serverID string, content string, tag int, archived bool) string {
+	return fmt.Sprintf(`
+	resource "discord_forum_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-forum-post-channel"
+	  topic = "Questions about the provider"
+	  sync_perms_with_category = false
+
+	  available_tag {
+	    name = "question"
+	  }
+
+	  available_tag {
+	    name = "resolved"
+	  }
+	}
+
+	resource "discord_forum_post" "example" {
+	  channel_id = discord_forum_channel.example.id
+	  name = "terraform-question"
+	  content = "%[2]s"
+	  applied_tags = [discord_forum_channel.example.available_tag[%[3]d].id]
+	  pinned = %[4]t
+	  archived = %[5]t
+	  locked = %[5]t
+	  audit_log_reason = "Managed by Terraform"
+
+	  embed {
+	    title = "Details"
+	    description = "Asked while testing the provider"
+	  }
+	}`, serverID, content, tag, !archived, archived)
+}
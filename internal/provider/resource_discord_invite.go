@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -36,6 +37,8 @@ type DiscordInviteModel struct {
 	Unique    types.Bool   `tfsdk:"unique"`
 	Code      types.String `tfsdk:"code"`
 	ID        types.String `tfsdk:"id"`
+
+	AuditLogReason types.String `tfsdk:"audit_log_reason"`
 }
 
 func (r *DiscordInvite) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -95,6 +98,7 @@ func (r *DiscordInvite) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:           true,
 				DeprecationMessage: "Use the `code` attribute instead",
 			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
 		},
 	}
 }
@@ -135,7 +139,7 @@ func (r *DiscordInvite) Create(ctx context.Context, req resource.CreateRequest,
 		MaxUses:   int(data.MaxUses.ValueInt64()),
 		Temporary: data.Temporary.ValueBool(),
 		Unique:    data.Unique.ValueBool(),
-	}, discordgo.WithContext(ctx))
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create a invite", err.Error())
 		return
@@ -148,6 +152,8 @@ func (r *DiscordInvite) Create(ctx context.Context, req resource.CreateRequest,
 		Unique:    types.BoolValue(invite.Unique),
 		Code:      types.StringValue(invite.Code),
 		ID:        types.StringValue(invite.Code),
+
+		AuditLogReason: data.AuditLogReason,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -166,6 +172,10 @@ func (r *DiscordInvite) Read(ctx context.Context, req resource.ReadRequest, resp
 	// In order to get the invite metadata, we need to get all the invites for the channel
 	// and then filter the invite we want by the code
 	invites, err := client.ChannelInvites(data.ChannelID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "channel", data.ChannelID.ValueString())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get invites from API", err.Error())
 		return
@@ -178,7 +188,7 @@ func (r *DiscordInvite) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 	}
 	if invite == nil {
-		resp.Diagnostics.AddError("Failed to get invite", "Invite not found in the channel")
+		utils.RemoveNotFound(ctx, resp, "invite", data.Code.ValueString())
 		return
 	}
 	data = &DiscordInviteModel{
@@ -189,6 +199,8 @@ func (r *DiscordInvite) Read(ctx context.Context, req resource.ReadRequest, resp
 		Unique:    types.BoolValue(invite.Unique),
 		Code:      types.StringValue(invite.Code),
 		ID:        types.StringValue(invite.Code),
+
+		AuditLogReason: data.AuditLogReason,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -203,7 +215,8 @@ func (r *DiscordInvite) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	resp.Diagnostics.AddError("failed to update discord invite", "Not implemented")
+	// Every other attribute replaces the invite, so only audit_log_reason can change here.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DiscordInvite) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -216,7 +229,7 @@ func (r *DiscordInvite) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 	client := r.client.Session
-	if _, err := client.InviteDelete(data.Code.ValueString(), discordgo.WithContext(ctx)); err != nil {
+	if _, err := client.InviteDelete(data.Code.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
 		resp.Diagnostics.AddError("Failed to delete invite", err.Error())
 		return
 	}
@@ -0,0 +1,484 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+	"time"
+)
+
+// memberOriginalKey is the private state key of the member settings recorded before the resource changed them.
+const memberOriginalKey = "original"
+
+// maxMemberTimeout is how far in the future Discord allows a timeout to end.
+const maxMemberTimeout = 28 * 24 * time.Hour
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordMemberResource{}
+var _ resource.ResourceWithImportState = &DiscordMemberResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordMemberResource{}
+
+func NewDiscordMemberResource() resource.Resource {
+	return &DiscordMemberResource{}
+}
+
+type DiscordMemberResource struct {
+	client *Context
+}
+
+type DiscordMemberResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	ServerID                   types.String `tfsdk:"server_id"`
+	UserID                     types.String `tfsdk:"user_id"`
+	Nick                       types.String `tfsdk:"nick"`
+	Mute                       types.Bool   `tfsdk:"mute"`
+	Deaf                       types.Bool   `tfsdk:"deaf"`
+	ChannelID                  types.String `tfsdk:"channel_id"`
+	CommunicationDisabledUntil types.String `tfsdk:"communication_disabled_until"`
+
+	AuditLogReason types.String `tfsdk:"audit_log_reason"`
+}
+
+// memberOriginal are the member settings that are restored when the resource is destroyed.
+type memberOriginal struct {
+	Nick                       string `json:"nick"`
+	Mute                       bool   `json:"mute"`
+	Deaf                       bool   `json:"deaf"`
+	CommunicationDisabledUntil string `json:"communication_disabled_until"`
+}
+
+func (r *DiscordMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_member"
+}
+
+func (r *DiscordMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Member Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the member in the form `<server id>:<member id>`",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": utils.ServerIDAttribute(),
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the member",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"nick": schema.StringAttribute{
+				Description: "The nickname of the member. An empty string resets it. Left unchanged when not set",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(32),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mute": schema.BoolAttribute{
+				Description: "Whether the member is muted in voice channels. Can only be changed while the member is connected to voice",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deaf": schema.BoolAttribute{
+				Description: "Whether the member is deafened in voice channels. Can only be changed while the member is connected to voice",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the voice channel to move the member to. The member must be connected to voice. Discord does not return the voice channel of a member, so moves made outside Terraform are not detected",
+				Optional:    true,
+			},
+			"communication_disabled_until": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp until which the member is timed out, at most 28 days in the future. An empty string removes the timeout",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					timeoutValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
+		},
+	}
+}
+
+func (r *DiscordMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DiscordMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
+func (r *DiscordMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	serverID := data.ServerID.ValueString()
+	userID := data.UserID.ValueString()
+	member, err := client.GuildMember(serverID, userID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get member %s", userID), err.Error())
+		return
+	}
+	original, err := json.Marshal(buildMemberOriginal(member))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to record member settings", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, memberOriginalKey, original)...)
+
+	// The member is only moved when channel_id is set, as there is no way to know which channel they are in.
+	current := buildMemberResourceModel(member, data)
+	current.ChannelID = types.StringNull()
+	memberEdit, diags := buildMemberEdit(data, *current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if memberEdit != nil {
+		member, err = utils.MemberEditComplex(client, serverID, userID, memberEdit, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update member %s", userID), err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildMemberResourceModel(member, data))...)
+}
+
+func (r *DiscordMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	member, err := client.GuildMember(data.ServerID.ValueString(), data.UserID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "member", data.ID.ValueString())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get member %s", data.UserID.ValueString()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildMemberResourceModel(member, data))...)
+}
+
+func (r *DiscordMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DiscordMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	serverID := plan.ServerID.ValueString()
+	userID := plan.UserID.ValueString()
+
+	memberEdit, diags := buildMemberEdit(plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var member *discordgo.Member
+	var err error
+	if memberEdit != nil {
+		member, err = utils.MemberEditComplex(client, serverID, userID, memberEdit, discordgo.WithContext(ctx), utils.WithAuditLogReason(plan.AuditLogReason))
+	} else {
+		member, err = client.GuildMember(serverID, userID, discordgo.WithContext(ctx))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update member %s", userID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildMemberResourceModel(member, plan))...)
+}
+
+func (r *DiscordMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stored, diags := req.Private.GetKey(ctx, memberOriginalKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || stored == nil {
+		return
+	}
+	var original memberOriginal
+	if err := json.Unmarshal(stored, &original); err != nil {
+		resp.Diagnostics.AddError("Failed to read recorded member settings", err.Error())
+		return
+	}
+
+	// The member is left in the server with the settings it had before the resource changed them.
+	client := r.client.Session
+	serverID := data.ServerID.ValueString()
+	userID := data.UserID.ValueString()
+	memberEdit := &utils.MemberEdit{}
+	if data.Nick.ValueString() != original.Nick {
+		memberEdit.Nick = &original.Nick
+	}
+	if data.CommunicationDisabledUntil.ValueString() != original.CommunicationDisabledUntil {
+		memberEdit.CommunicationDisabledUntil = &original.CommunicationDisabledUntil
+	}
+	if memberEdit.Nick != nil || memberEdit.CommunicationDisabledUntil != nil {
+		_, err := utils.MemberEditComplex(client, serverID, userID, memberEdit, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+		if utils.IsNotFound(err) {
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to restore member %s", userID), err.Error())
+			return
+		}
+	}
+
+	voiceEdit := &utils.MemberEdit{}
+	if data.Mute.ValueBool() != original.Mute {
+		voiceEdit.Mute = &original.Mute
+	}
+	if data.Deaf.ValueBool() != original.Deaf {
+		voiceEdit.Deaf = &original.Deaf
+	}
+	if voiceEdit.Mute != nil || voiceEdit.Deaf != nil {
+		_, err := utils.MemberEditComplex(client, serverID, userID, voiceEdit, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+		var restErr *discordgo.RESTError
+		if errors.As(err, &restErr) && restErr.Message != nil && restErr.Message.Code == discordgo.ErrCodeTargetIsNotConnectedToVoice {
+			tflog.Warn(ctx, "Member is not connected to voice, so the original mute and deafen settings were not restored", map[string]interface{}{
+				"server_id": serverID,
+				"user_id":   userID,
+			})
+			return
+		}
+		if err != nil && !utils.IsNotFound(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to restore member %s", userID), err.Error())
+			return
+		}
+	}
+}
+
+func (r *DiscordMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverID, userID, ok := strings.Cut(req.ID, ":")
+	if !ok || serverID == "" || userID == "" {
+		resp.Diagnostics.AddError("error importing Discord Member", "invalid ID specified. Please specify the ID as \"<server id>:<member id>\"")
+		return
+	}
+	member, err := r.client.Session.GuildMember(serverID, userID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get member %s", userID), err.Error())
+		return
+	}
+	// Imported members are restored to the settings they had when they were imported.
+	original, err := json.Marshal(buildMemberOriginal(member))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to record member settings", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, memberOriginalKey, original)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+}
+
+// buildMemberEdit returns the changes from current to data, or nil when there are none. Only changed fields are
+// sent, because Discord rejects voice settings for members that are not connected to voice.
+func buildMemberEdit(data DiscordMemberResourceModel, current DiscordMemberResourceModel) (*utils.MemberEdit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	memberEdit := &utils.MemberEdit{}
+	changed := false
+	if !data.Nick.IsUnknown() && data.Nick.ValueString() != current.Nick.ValueString() {
+		memberEdit.Nick = data.Nick.ValueStringPointer()
+		changed = true
+	}
+	if !data.Mute.IsUnknown() && data.Mute.ValueBool() != current.Mute.ValueBool() {
+		memberEdit.Mute = data.Mute.ValueBoolPointer()
+		changed = true
+	}
+	if !data.Deaf.IsUnknown() && data.Deaf.ValueBool() != current.Deaf.ValueBool() {
+		memberEdit.Deaf = data.Deaf.ValueBoolPointer()
+		changed = true
+	}
+	if !data.ChannelID.IsNull() && !data.ChannelID.Equal(current.ChannelID) {
+		memberEdit.ChannelID = data.ChannelID.ValueStringPointer()
+		changed = true
+	}
+	if !data.CommunicationDisabledUntil.IsUnknown() && !sameTimestamp(activeTimeout(data.CommunicationDisabledUntil.ValueString()), activeTimeout(current.CommunicationDisabledUntil.ValueString())) {
+		timeout := activeTimeout(data.CommunicationDisabledUntil.ValueString())
+		if timeout != "" {
+			until, err := time.Parse(time.RFC3339, timeout)
+			if err != nil {
+				diags.AddAttributeError(path.Root("communication_disabled_until"), "Invalid timeout", fmt.Sprintf("%q is not an RFC 3339 timestamp.", timeout))
+				return nil, diags
+			}
+			timeout = until.UTC().Format(time.RFC3339)
+		}
+		memberEdit.CommunicationDisabledUntil = &timeout
+		changed = true
+	}
+	if !changed {
+		return nil, diags
+	}
+
+	return memberEdit, diags
+}
+
+// buildMemberResourceModel returns the member as the resource sees it. channel_id cannot be read, so it is kept from
+// data, as is the timeout when data has the same time written differently or a timeout that has since expired.
+func buildMemberResourceModel(member *discordgo.Member, data DiscordMemberResourceModel) *DiscordMemberResourceModel {
+	serverID := data.ServerID.ValueString()
+	userID := data.UserID.ValueString()
+	timeout := ""
+	if member.CommunicationDisabledUntil != nil {
+		timeout = activeTimeout(member.CommunicationDisabledUntil.UTC().Format(time.RFC3339))
+	}
+	communicationDisabledUntil := types.StringValue(timeout)
+	if data.CommunicationDisabledUntil.ValueString() != "" && sameTimestamp(activeTimeout(data.CommunicationDisabledUntil.ValueString()), timeout) {
+		communicationDisabledUntil = data.CommunicationDisabledUntil
+	}
+	channelID := data.ChannelID
+	if channelID.IsUnknown() {
+		channelID = types.StringNull()
+	}
+
+	return &DiscordMemberResourceModel{
+		ID:                         types.StringValue(fmt.Sprintf("%s:%s", serverID, userID)),
+		ServerID:                   types.StringValue(serverID),
+		UserID:                     types.StringValue(userID),
+		Nick:                       types.StringValue(member.Nick),
+		Mute:                       types.BoolValue(member.Mute),
+		Deaf:                       types.BoolValue(member.Deaf),
+		ChannelID:                  channelID,
+		CommunicationDisabledUntil: communicationDisabledUntil,
+		AuditLogReason:             data.AuditLogReason,
+	}
+}
+
+func buildMemberOriginal(member *discordgo.Member) memberOriginal {
+	original := memberOriginal{
+		Nick: member.Nick,
+		Mute: member.Mute,
+		Deaf: member.Deaf,
+	}
+	if member.CommunicationDisabledUntil != nil {
+		original.CommunicationDisabledUntil = member.CommunicationDisabledUntil.UTC().Format(time.RFC3339)
+	}
+
+	return original
+}
+
+// activeTimeout returns the timeout, or an empty string when it has already expired. Discord stops returning a
+// timeout once it ends, so an expired one is the same as none.
+func activeTimeout(timeout string) string {
+	until, err := time.Parse(time.RFC3339, timeout)
+	if err == nil && !until.After(time.Now()) {
+		return ""
+	}
+
+	return timeout
+}
+
+// timeoutValidator checks that a timeout is an RFC 3339 timestamp at most 28 days in the future. Timestamps in the
+// past are allowed, as every timeout ends up there once it expires.
+type timeoutValidator struct{}
+
+func (v timeoutValidator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp at most 28 days in the future"
+}
+
+func (v timeoutValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeoutValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+	timeout := req.ConfigValue.ValueString()
+	until, err := time.Parse(time.RFC3339, timeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid timeout", fmt.Sprintf("%q is not an RFC 3339 timestamp.", timeout))
+		return
+	}
+	if until.After(time.Now().Add(maxMemberTimeout)) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid timeout", fmt.Sprintf("Members can be timed out for at most 28 days, %s is further in the future.", timeout))
+	}
+}
+
+// sameTimestamp reports whether two RFC 3339 timestamps are the same time. Empty strings are only the same as each
+// other.
+func sameTimestamp(a string, b string) bool {
+	if a == "" || b == "" {
+		return a == b
+	}
+	timeA, errA := time.Parse(time.RFC3339, a)
+	timeB, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+
+	return timeA.Equal(timeB)
+}
@@ -0,0 +1,381 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordMemberRolesResource{}
+var _ resource.ResourceWithImportState = &DiscordMemberRolesResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordMemberRolesResource{}
+
+func NewDiscordMemberRolesResource() resource.Resource {
+	return &DiscordMemberRolesResource{}
+}
+
+type DiscordMemberRolesResource struct {
+	client *Context
+}
+
+type DiscordMemberRolesModel struct {
+	ID            types.String             `tfsdk:"id"`
+	ServerID      types.String             `tfsdk:"server_id"`
+	UserID        types.String             `tfsdk:"user_id"`
+	Authoritative types.Bool               `tfsdk:"authoritative"`
+	Role          []DiscordMemberRoleModel `tfsdk:"role"`
+
+	AuditLogReason types.String `tfsdk:"audit_log_reason"`
+}
+
+type DiscordMemberRoleModel struct {
+	RoleID  types.String `tfsdk:"role_id"`
+	HasRole types.Bool   `tfsdk:"has_role"`
+}
+
+func (r *DiscordMemberRolesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_member_roles"
+}
+
+func (r *DiscordMemberRolesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Member Roles Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the member roles in the form `<server id>:<member id>`",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": utils.ServerIDAttribute(),
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the member",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authoritative": schema.BoolAttribute{
+				Description: "Whether the `role` blocks are the only roles the member has. Other roles are removed from the member, except roles managed by an integration. When false, roles not listed are left alone",
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+				Computed:    true,
+			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"role": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"role_id": schema.StringAttribute{
+							Description: "The ID of the role",
+							Required:    true,
+						},
+						"has_role": schema.BoolAttribute{
+							Description: "Whether the member has the role. Set to false to make sure the member does not have it",
+							Optional:    true,
+							Default:     booldefault.StaticBool(true),
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DiscordMemberRolesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DiscordMemberRolesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
+func (r *DiscordMemberRolesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordMemberRolesModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model, diags := r.read(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordMemberRolesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordMemberRolesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported member roles have no role blocks yet, so they start with every role the member has.
+	imported := data.Authoritative.IsNull()
+	if imported {
+		data.Authoritative = types.BoolValue(true)
+	}
+	model, diags := r.read(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if model == nil {
+		utils.RemoveNotFound(ctx, resp, "member", data.ID.ValueString())
+		return
+	}
+	if imported {
+		model.Authoritative = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordMemberRolesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DiscordMemberRolesModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan, state.Role)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model, diags := r.read(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordMemberRolesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordMemberRolesModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	serverID := data.ServerID.ValueString()
+	userID := data.UserID.ValueString()
+	member, err := client.GuildMember(serverID, userID, discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get member %s", userID), err.Error())
+		return
+	}
+	// Only the roles given by this resource are taken away again.
+	for _, role := range data.Role {
+		roleID := role.RoleID.ValueString()
+		if !role.HasRole.ValueBool() || !utils.HasRole(member, roleID) {
+			continue
+		}
+		err := client.GuildMemberRoleRemove(serverID, userID, roleID, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+		if err != nil && !utils.IsNotFound(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to remove role %s from member %s", roleID, userID), err.Error())
+			return
+		}
+	}
+}
+
+func (r *DiscordMemberRolesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverID, userID, ok := strings.Cut(req.ID, ":")
+	if !ok || serverID == "" || userID == "" {
+		resp.Diagnostics.AddError("error importing Discord Member Roles", "invalid ID specified. Please specify the ID as \"<server id>:<member id>\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+}
+
+// apply gives and takes away roles so the member matches data. Roles that were in previous but are no longer in
+// data are taken away when they were given by the resource.
+func (r *DiscordMemberRolesResource) apply(ctx context.Context, data DiscordMemberRolesModel, previous []DiscordMemberRoleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := r.client.Session
+	serverID := data.ServerID.ValueString()
+	userID := data.UserID.ValueString()
+
+	wanted := make(map[string]bool, len(data.Role))
+	for _, role := range data.Role {
+		roleID := role.RoleID.ValueString()
+		if hasRole, ok := wanted[roleID]; ok && hasRole != role.HasRole.ValueBool() {
+			diags.AddAttributeError(path.Root("role"), "Conflicting role", fmt.Sprintf("Role %s is both given and taken away.", roleID))
+			return diags
+		}
+		wanted[roleID] = role.HasRole.ValueBool()
+	}
+	member, err := client.GuildMember(serverID, userID, discordgo.WithContext(ctx))
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to get member %s", userID), err.Error())
+		return diags
+	}
+
+	remove := map[string]bool{}
+	for _, role := range previous {
+		if _, ok := wanted[role.RoleID.ValueString()]; !ok && role.HasRole.ValueBool() {
+			remove[role.RoleID.ValueString()] = true
+		}
+	}
+	if data.Authoritative.ValueBool() {
+		managed, err := managedRoles(ctx, client, serverID)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to get roles of server %s", serverID), err.Error())
+			return diags
+		}
+		for _, roleID := range member.Roles {
+			if _, ok := wanted[roleID]; !ok && !managed[roleID] {
+				remove[roleID] = true
+			}
+		}
+	}
+	for roleID, hasRole := range wanted {
+		if !hasRole {
+			remove[roleID] = true
+			continue
+		}
+		if utils.HasRole(member, roleID) {
+			continue
+		}
+		if err := client.GuildMemberRoleAdd(serverID, userID, roleID, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+			diags.AddError(fmt.Sprintf("Failed to add role %s to member %s", roleID, userID), err.Error())
+			return diags
+		}
+	}
+	for roleID := range remove {
+		if !utils.HasRole(member, roleID) {
+			continue
+		}
+		if err := client.GuildMemberRoleRemove(serverID, userID, roleID, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+			diags.AddError(fmt.Sprintf("Failed to remove role %s from member %s", roleID, userID), err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// read returns the roles of data as the member has them. In authoritative mode the other roles of the member are
+// included too, so that roles given outside Terraform show up as drift. It returns nil when the member is not in the
+// server.
+func (r *DiscordMemberRolesResource) read(ctx context.Context, data DiscordMemberRolesModel) (*DiscordMemberRolesModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	client := r.client.Session
+	serverID := data.ServerID.ValueString()
+	userID := data.UserID.ValueString()
+	member, err := client.GuildMember(serverID, userID, discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		return nil, diags
+	}
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to get member %s", userID), err.Error())
+		return nil, diags
+	}
+
+	roles := make([]DiscordMemberRoleModel, 0, len(data.Role))
+	listed := make(map[string]bool, len(data.Role))
+	for _, role := range data.Role {
+		roleID := role.RoleID.ValueString()
+		listed[roleID] = true
+		roles = append(roles, DiscordMemberRoleModel{
+			RoleID:  types.StringValue(roleID),
+			HasRole: types.BoolValue(utils.HasRole(member, roleID)),
+		})
+	}
+	if data.Authoritative.ValueBool() {
+		managed, err := managedRoles(ctx, client, serverID)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to get roles of server %s", serverID), err.Error())
+			return nil, diags
+		}
+		for _, roleID := range member.Roles {
+			if listed[roleID] || managed[roleID] {
+				continue
+			}
+			roles = append(roles, DiscordMemberRoleModel{
+				RoleID:  types.StringValue(roleID),
+				HasRole: types.BoolValue(true),
+			})
+		}
+	}
+
+	return &DiscordMemberRolesModel{
+		ID:             types.StringValue(fmt.Sprintf("%s:%s", serverID, userID)),
+		ServerID:       types.StringValue(serverID),
+		UserID:         types.StringValue(userID),
+		Authoritative:  data.Authoritative,
+		Role:           roles,
+		AuditLogReason: data.AuditLogReason,
+	}, diags
+}
+
+// managedRoles returns the IDs of the roles in a server that are managed by an integration and cannot be given or
+// taken away.
+func managedRoles(ctx context.Context, client *discordgo.Session, serverID string) (map[string]bool, error) {
+	roles, err := client.GuildRoles(serverID, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	managed := map[string]bool{}
+	for _, role := range roles {
+		if role.Managed {
+			managed[role.ID] = true
+		}
+	}
+
+	return managed, nil
+}
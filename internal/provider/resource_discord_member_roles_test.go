@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccResourceDiscordMemberRoles(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testUserID := os.Getenv("DISCORD_TEST_USER_ID")
+	if testServerID == "" || testUserID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID and DISCORD_TEST_USER_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_member_roles.example"
+	var unmanaged *discordgo.Role
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordMemberRoles(testServerID, testUserID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", fmt.Sprintf("%s:%s", testServerID, testUserID)),
+					resource.TestCheckResourceAttr(name, "authoritative", "false"),
+					resource.TestCheckResourceAttr(name, "role.#", "2"),
+					testAccCheckMemberHasRole(t, testServerID, testUserID, "discord_role.member", true),
+					testAccCheckMemberHasRole(t, testServerID, testUserID, "discord_role.not_member", false),
+				),
+			},
+			{
+				// A role given outside Terraform is taken away again, and roles not listed are left alone.
+				PreConfig: func() {
+					client := testAccClient(t).Session
+					roles, err := client.GuildRoles(testServerID)
+					if err != nil {
+						t.Fatal(err)
+					}
+					for _, role := range roles {
+						if role.Name == "terraform-member-roles-not-member" {
+							if err := client.GuildMemberRoleAdd(testServerID, testUserID, role.ID); err != nil {
+								t.Fatal(err)
+							}
+						}
+					}
+					unmanaged, err = client.GuildRoleCreate(testServerID, &discordgo.RoleParams{Name: "terraform-member-roles-unmanaged"})
+					if err != nil {
+						t.Fatal(err)
+					}
+					if err := client.GuildMemberRoleAdd(testServerID, testUserID, unmanaged.ID); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccResourceDiscordMemberRoles(testServerID, testUserID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "role.#", "2"),
+					testAccCheckMemberHasRole(t, testServerID, testUserID, "discord_role.not_member", false),
+					func(s *terraform.State) error {
+						return testAccCheckMemberRole(t, testServerID, testUserID, unmanaged.ID, true)
+					},
+				),
+			},
+			{
+				// Authoritative member roles take away every role that is not listed.
+				Config: testAccResourceDiscordMemberRoles(testServerID, testUserID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "authoritative", "true"),
+					resource.TestCheckResourceAttr(name, "role.#", "2"),
+					testAccCheckMemberHasRole(t, testServerID, testUserID, "discord_role.member", true),
+					func(s *terraform.State) error {
+						return testAccCheckMemberRole(t, testServerID, testUserID, unmanaged.ID, false)
+					},
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:%s", testServerID, testUserID),
+				ImportStateVerify: true,
+				// Imported member roles list the roles the member has, and are not authoritative.
+				ImportStateVerifyIgnore: []string{"audit_log_reason", "authoritative", "role"},
+			},
+		},
+	})
+}
+
+func testAccCheckMemberHasRole(t *testing.T, serverID string, userID string, roleName string, want bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		role, ok := s.RootModule().Resources[roleName]
+		if !ok {
+			return fmt.Errorf("%s not found", roleName)
+		}
+		return testAccCheckMemberRole(t, serverID, userID, role.Primary.ID, want)
+	}
+}
+
+func testAccCheckMemberRole(t *testing.T, serverID string, userID string, roleID string, want bool) error {
+	member, err := testAccClient(t).Session.GuildMember(serverID, userID)
+	if err != nil {
+		return err
+	}
+	if got := utils.HasRole(member, roleID); got != want {
+		return fmt.Errorf("expected member %s to have role %s: %t, got %t", userID, roleID, want, got)
+	}
+
+	return nil
+}
+
+func testAccResourceDiscordMemberRoles(serverID string, userID string, authoritative bool) string {
+	return fmt.Sprintf(`
+	resource "discord_role" "member" {
+	  server_id = "%[1]s"
+	  name = "terraform-member-roles-member"
+	  color = 0
+	  permissions = 0
+	}
+
+	resource "discord_role" "not_member" {
+	  server_id = "%[1]s"
+	  name = "terraform-member-roles-not-member"
+	  color = 0
+	  permissions = 0
+	}
+
+	resource "discord_member_roles" "example" {
+	  server_id = "%[1]s"
+	  user_id = "%[2]s"
+	  authoritative = %[3]t
+	  audit_log_reason = "Managed by Terraform"
+
+	  role {
+	    role_id = discord_role.member.id
+	  }
+
+	  role {
+	    role_id = discord_role.not_member.id
+	    has_role = false
+	  }
+	}`, serverID, userID, authoritative)
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestAccResourceDiscordMember(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testUserID := os.Getenv("DISCORD_TEST_VOICE_USER_ID")
+	if testServerID == "" || testUserID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID and DISCORD_TEST_VOICE_USER_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_member.example"
+	// Written with an offset instead of Z, which Discord returns, to check that the same time is not a change.
+	timeout := time.Now().Add(24 * time.Hour).Truncate(time.Second).In(time.FixedZone("", 2*60*60)).Format(time.RFC3339)
+	expired := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			// Destroying the resource restores the member instead of kicking them.
+			member, err := testAccClient(t).Session.GuildMember(testServerID, testUserID)
+			if err != nil {
+				return err
+			}
+			if member.Nick != "" || member.Mute || member.Deaf || member.CommunicationDisabledUntil != nil {
+				return fmt.Errorf("member %s was not restored: %+v", testUserID, member)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceDiscordMember(testServerID, testUserID, "terraform-staff", true, time.Now().Add(30*24*time.Hour).UTC().Format(time.RFC3339)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Members can be timed out for at most 28 days"),
+			},
+			{
+				Config: testAccResourceDiscordMember(testServerID, testUserID, "terraform-staff", true, timeout),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", fmt.Sprintf("%s:%s", testServerID, testUserID)),
+					resource.TestCheckResourceAttr(name, "nick", "terraform-staff"),
+					resource.TestCheckResourceAttr(name, "mute", "true"),
+					resource.TestCheckResourceAttr(name, "deaf", "true"),
+					resource.TestCheckResourceAttr(name, "communication_disabled_until", timeout),
+					resource.TestCheckResourceAttrPair(name, "channel_id", "discord_voice_channel.example", "id"),
+				),
+			},
+			{
+				// An expired timeout is the same as none, which is how Discord returns it.
+				Config: testAccResourceDiscordMember(testServerID, testUserID, "terraform-staff", true, expired),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "communication_disabled_until", expired),
+					func(_ *terraform.State) error {
+						member, err := testAccClient(t).Session.GuildMember(testServerID, testUserID)
+						if err != nil {
+							return err
+						}
+						if member.CommunicationDisabledUntil != nil {
+							return fmt.Errorf("expected the timeout of member %s to be removed, got %s", testUserID, member.CommunicationDisabledUntil)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccResourceDiscordMember(testServerID, testUserID, "", false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "nick", ""),
+					resource.TestCheckResourceAttr(name, "mute", "false"),
+					resource.TestCheckResourceAttr(name, "deaf", "true"),
+					resource.TestCheckResourceAttr(name, "communication_disabled_until", ""),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// channel_id cannot be read from Discord, and audit_log_reason only exists in the configuration.
+				ImportStateVerifyIgnore: []string{"audit_log_reason", "channel_id"},
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordMember(serverID string, userID string, nick string, mute bool, timeout string) string {
+	return fmt.Sprintf(`
+	resource "discord_voice_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-member-voice-channel"
+	  position = 1
+	  bitrate = 64000
+	  user_limit = 4
+	  sync_perms_with_category = false
+	}
+
+	resource "discord_member" "example" {
+	  server_id = "%[1]s"
+	  user_id = "%[2]s"
+	  nick = "%[3]s"
+	  mute = %[4]t
+	  deaf = true
+	  channel_id = discord_voice_channel.example.id
+	  communication_disabled_until = "%[5]s"
+	  audit_log_reason = "Managed by Terraform"
+	}`, serverID, userID, nick, mute, timeout)
+}
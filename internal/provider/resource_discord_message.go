@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -166,171 +167,176 @@ func (r *DiscordMessage) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"embed": schema.SetNestedBlock{
-				NestedObject: schema.NestedBlockObject{
+			"embed": messageEmbedBlock(),
+		},
+	}
+}
+
+// messageEmbedBlock is the schema of the embeds sent with a message.
+func messageEmbedBlock() schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"title": schema.StringAttribute{
+					MarkdownDescription: "The title of the embed",
+					Optional:            true,
+					Computed:            true,
+				},
+				"description": schema.StringAttribute{
+					MarkdownDescription: "The description of the embed",
+					Optional:            true,
+					Computed:            true,
+				},
+				"url": schema.StringAttribute{
+					MarkdownDescription: "The URL of the embed",
+					Optional:            true,
+					Computed:            true,
+				},
+				"timestamp": schema.StringAttribute{
+					MarkdownDescription: "The timestamp of the embed",
+					Optional:            true,
+					Computed:            true,
+				},
+				"color": schema.Int64Attribute{
+					MarkdownDescription: "The color of the embed",
+					Optional:            true,
+					Computed:            true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"footer": schema.SingleNestedBlock{
 					Attributes: map[string]schema.Attribute{
-						"title": schema.StringAttribute{
-							MarkdownDescription: "The title of the embed",
+						"text": schema.StringAttribute{
+							MarkdownDescription: "The text of the footer",
 							Optional:            true,
 							Computed:            true,
 						},
-						"description": schema.StringAttribute{
-							MarkdownDescription: "The description of the embed",
+						"icon_url": schema.StringAttribute{
+							MarkdownDescription: "The icon URL of the footer",
 							Optional:            true,
 							Computed:            true,
 						},
+						"proxy_icon_url": schema.StringAttribute{
+							MarkdownDescription: "The proxy icon URL of the footer",
+							Computed:            true,
+						},
+					},
+				},
+				"image": schema.SingleNestedBlock{
+					Attributes: map[string]schema.Attribute{
 						"url": schema.StringAttribute{
-							MarkdownDescription: "The URL of the embed",
+							MarkdownDescription: "The URL of the image",
 							Optional:            true,
 							Computed:            true,
 						},
-						"timestamp": schema.StringAttribute{
-							MarkdownDescription: "The timestamp of the embed",
+						"proxy_url": schema.StringAttribute{
+							MarkdownDescription: "The proxy URL of the image",
+							Computed:            true,
+						},
+						"height": schema.Int64Attribute{
+							MarkdownDescription: "The height of the image",
 							Optional:            true,
 							Computed:            true,
 						},
-						"color": schema.Int64Attribute{
-							MarkdownDescription: "The color of the embed",
+						"width": schema.Int64Attribute{
+							MarkdownDescription: "The width of the image",
 							Optional:            true,
 							Computed:            true,
 						},
 					},
-					Blocks: map[string]schema.Block{
-						"footer": schema.SingleNestedBlock{
-							Attributes: map[string]schema.Attribute{
-								"text": schema.StringAttribute{
-									MarkdownDescription: "The text of the footer",
-									Optional:            true,
-									Computed:            true,
-								},
-								"icon_url": schema.StringAttribute{
-									MarkdownDescription: "The icon URL of the footer",
-									Optional:            true,
-									Computed:            true,
-								},
-								"proxy_icon_url": schema.StringAttribute{
-									MarkdownDescription: "The proxy icon URL of the footer",
-									Computed:            true,
-								},
-							},
+				},
+				"thumbnail": schema.SingleNestedBlock{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the thumbnail",
+							Optional:            true,
+							Computed:            true,
 						},
-						"image": schema.SingleNestedBlock{
-							Attributes: map[string]schema.Attribute{
-								"url": schema.StringAttribute{
-									MarkdownDescription: "The URL of the image",
-									Optional:            true,
-									Computed:            true,
-								},
-								"proxy_url": schema.StringAttribute{
-									MarkdownDescription: "The proxy URL of the image",
-									Computed:            true,
-								},
-								"height": schema.Int64Attribute{
-									MarkdownDescription: "The height of the image",
-									Optional:            true,
-									Computed:            true,
-								},
-								"width": schema.Int64Attribute{
-									MarkdownDescription: "The width of the image",
-									Optional:            true,
-									Computed:            true,
-								},
-							},
+						"proxy_url": schema.StringAttribute{
+							MarkdownDescription: "The proxy URL of the thumbnail",
+							Computed:            true,
 						},
-						"thumbnail": schema.SingleNestedBlock{
-							Attributes: map[string]schema.Attribute{
-								"url": schema.StringAttribute{
-									MarkdownDescription: "The URL of the thumbnail",
-									Optional:            true,
-									Computed:            true,
-								},
-								"proxy_url": schema.StringAttribute{
-									MarkdownDescription: "The proxy URL of the thumbnail",
-									Computed:            true,
-								},
-								"height": schema.Int64Attribute{
-									MarkdownDescription: "The height of the thumbnail",
-									Optional:            true,
-									Computed:            true,
-								},
-								"width": schema.Int64Attribute{
-									MarkdownDescription: "The width of the thumbnail",
-									Optional:            true,
-									Computed:            true,
-								},
-							},
+						"height": schema.Int64Attribute{
+							MarkdownDescription: "The height of the thumbnail",
+							Optional:            true,
+							Computed:            true,
 						},
-						"video": schema.SingleNestedBlock{
-							Attributes: map[string]schema.Attribute{
-								"url": schema.StringAttribute{
-									MarkdownDescription: "The URL of the video",
-									Optional:            true,
-									Computed:            true,
-								},
-								"height": schema.Int64Attribute{
-									MarkdownDescription: "The height of the video",
-									Optional:            true,
-								},
-								"width": schema.Int64Attribute{
-									MarkdownDescription: "The width of the video",
-									Optional:            true,
-								},
-							},
+						"width": schema.Int64Attribute{
+							MarkdownDescription: "The width of the thumbnail",
+							Optional:            true,
+							Computed:            true,
 						},
-						"provider": schema.SingleNestedBlock{
-							Attributes: map[string]schema.Attribute{
-								"name": schema.StringAttribute{
-									MarkdownDescription: "The name of the provider",
-									Optional:            true,
-								},
-								"url": schema.StringAttribute{
-									MarkdownDescription: "The URL of the provider",
-									Optional:            true,
-								},
-							},
+					},
+				},
+				"video": schema.SingleNestedBlock{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the video",
+							Optional:            true,
+							Computed:            true,
 						},
-						"author": schema.SingleNestedBlock{
-							Attributes: map[string]schema.Attribute{
-								"name": schema.StringAttribute{
-									MarkdownDescription: "The name of the author",
-									Optional:            true,
-									Computed:            true,
-								},
-								"url": schema.StringAttribute{
-									MarkdownDescription: "The URL of the author",
-									Optional:            true,
-									Computed:            true,
-								},
-								"icon_url": schema.StringAttribute{
-									MarkdownDescription: "The icon URL of the author",
-									Optional:            true,
-									Computed:            true,
-								},
-								"proxy_icon_url": schema.StringAttribute{
-									MarkdownDescription: "The proxy icon URL of the author",
-									Computed:            true,
-								},
-							},
+						"height": schema.Int64Attribute{
+							MarkdownDescription: "The height of the video",
+							Optional:            true,
+						},
+						"width": schema.Int64Attribute{
+							MarkdownDescription: "The width of the video",
+							Optional:            true,
+						},
+					},
+				},
+				"provider": schema.SingleNestedBlock{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the provider",
+							Optional:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the provider",
+							Optional:            true,
 						},
-						"fields": schema.SetNestedBlock{
-							Validators: []validator.Set{
-								setvalidator.SizeAtMost(25),
+					},
+				},
+				"author": schema.SingleNestedBlock{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the author",
+							Optional:            true,
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the author",
+							Optional:            true,
+							Computed:            true,
+						},
+						"icon_url": schema.StringAttribute{
+							MarkdownDescription: "The icon URL of the author",
+							Optional:            true,
+							Computed:            true,
+						},
+						"proxy_icon_url": schema.StringAttribute{
+							MarkdownDescription: "The proxy icon URL of the author",
+							Computed:            true,
+						},
+					},
+				},
+				"fields": schema.SetNestedBlock{
+					Validators: []validator.Set{
+						setvalidator.SizeAtMost(25),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the field",
+								Required:            true,
+							},
+							"value": schema.StringAttribute{
+								MarkdownDescription: "The value of the field",
+								Optional:            true,
 							},
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"name": schema.StringAttribute{
-										MarkdownDescription: "The name of the field",
-										Required:            true,
-									},
-									"value": schema.StringAttribute{
-										MarkdownDescription: "The value of the field",
-										Optional:            true,
-									},
-									"inline": schema.BoolAttribute{
-										MarkdownDescription: "Whether the field is inline",
-										Optional:            true,
-									},
-								},
+							"inline": schema.BoolAttribute{
+								MarkdownDescription: "Whether the field is inline",
+								Optional:            true,
 							},
 						},
 					},
@@ -368,7 +374,7 @@ func (r *DiscordMessage) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 	client := r.client.Session
-	embeds := buildEmbedMessages(data)
+	embeds := buildEmbedMessages(data.Embed)
 	message, err := client.ChannelMessageSendComplex(data.ChannelID.ValueString(), &discordgo.MessageSend{
 		Content: data.Content.ValueString(),
 		Embeds:  embeds,
@@ -414,6 +420,10 @@ func (r *DiscordMessage) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 	client := r.client.Session
 	message, err := client.ChannelMessage(data.ChannelID.ValueString(), data.ID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "message", data.ID.ValueString())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get message", err.Error())
 		return
@@ -446,7 +456,7 @@ func (r *DiscordMessage) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 	client := r.client.Session
-	embeds := buildEmbedMessages(data)
+	embeds := buildEmbedMessages(data.Embed)
 	message, err := client.ChannelMessageEditComplex(&discordgo.MessageEdit{
 		Channel: data.ChannelID.ValueString(),
 		ID:      data.ID.ValueString(),
@@ -510,9 +520,9 @@ func (r *DiscordMessage) ImportState(ctx context.Context, req resource.ImportSta
 	)...)
 }
 
-func buildEmbedMessages(data DiscordMessageModel) []*discordgo.MessageEmbed {
-	embeds := make([]*discordgo.MessageEmbed, 0, len(data.Embed))
-	for _, embed := range data.Embed {
+func buildEmbedMessages(models []DiscordMessageEmbedModel) []*discordgo.MessageEmbed {
+	embeds := make([]*discordgo.MessageEmbed, 0, len(models))
+	for _, embed := range models {
 		base := &discordgo.MessageEmbed{
 			Title:       embed.Title.ValueString(),
 			Description: embed.Description.ValueString(),
@@ -17,6 +17,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DiscordRoleResource{}
 var _ resource.ResourceWithImportState = &DiscordRoleResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordRoleResource{}
 
 func NewDiscordRoleResource() resource.Resource {
 	return &DiscordRoleResource{}
@@ -26,6 +27,19 @@ type DiscordRoleResource struct {
 	client *Context
 }
 
+type DiscordRoleResourceModel struct {
+	ServerID       types.String `tfsdk:"server_id"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Position       types.Int64  `tfsdk:"position"`
+	Color          types.Int64  `tfsdk:"color"`
+	Permissions    types.Int64  `tfsdk:"permissions"`
+	Hoist          types.Bool   `tfsdk:"hoist"`
+	Mentionable    types.Bool   `tfsdk:"mentionable"`
+	Managed        types.Bool   `tfsdk:"managed"`
+	AuditLogReason types.String `tfsdk:"audit_log_reason"`
+}
+
 func (r *DiscordRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_role"
 }
@@ -39,10 +53,7 @@ func (r *DiscordRoleResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "The role ID",
 				Computed:            true,
 			},
-			"server_id": schema.StringAttribute{
-				MarkdownDescription: "The server ID",
-				Required:            true,
-			},
+			"server_id": utils.ServerIDAttribute(),
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The role name",
 				Required:            true,
@@ -77,6 +88,7 @@ func (r *DiscordRoleResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "The permissions of the role",
 				Optional:            true,
 			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
 		},
 	}
 }
@@ -100,10 +112,17 @@ func (r *DiscordRoleResource) Configure(ctx context.Context, req resource.Config
 	r.client = client
 }
 
+func (r *DiscordRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
 func (r *DiscordRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data DiscordRoleModel
+	var data DiscordRoleResourceModel
 
-	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -116,27 +135,28 @@ func (r *DiscordRoleResource) Create(ctx context.Context, req resource.CreateReq
 		Color:       &roleColor,
 		Hoist:       data.Hoist.ValueBoolPointer(),
 		Mentionable: data.Mentionable.ValueBoolPointer(),
-	}, discordgo.WithContext(ctx))
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create role", err.Error())
 		return
 	}
-	data = DiscordRoleModel{
-		ID:          types.StringValue(role.ID),
-		ServerID:    data.ServerID,
-		Name:        types.StringValue(role.Name),
-		Position:    types.Int64Value(int64(role.Position)),
-		Color:       types.Int64Value(int64(role.Color)),
-		Permissions: types.Int64Value(role.Permissions),
-		Hoist:       types.BoolValue(role.Hoist),
-		Mentionable: types.BoolValue(role.Mentionable),
-		Managed:     types.BoolValue(role.Managed),
+	data = DiscordRoleResourceModel{
+		ID:             types.StringValue(role.ID),
+		ServerID:       data.ServerID,
+		Name:           types.StringValue(role.Name),
+		Position:       types.Int64Value(int64(role.Position)),
+		Color:          types.Int64Value(int64(role.Color)),
+		Permissions:    types.Int64Value(role.Permissions),
+		Hoist:          types.BoolValue(role.Hoist),
+		Mentionable:    types.BoolValue(role.Mentionable),
+		Managed:        types.BoolValue(role.Managed),
+		AuditLogReason: data.AuditLogReason,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DiscordRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data DiscordRoleModel
+	var data DiscordRoleResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
@@ -145,27 +165,32 @@ func (r *DiscordRoleResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	client := r.client.Session
 	role, err := utils.GetRole(ctx, client, data.ServerID.ValueString(), data.ID.ValueString())
+	if role == nil && (err == nil || utils.IsNotFound(err)) {
+		utils.RemoveNotFound(ctx, resp, "role", data.ID.ValueString())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch role: %s", data.ID.ValueString()), err.Error())
 		return
 	}
-	data = DiscordRoleModel{
-		ID:          types.StringValue(role.ID),
-		ServerID:    data.ServerID,
-		Name:        types.StringValue(role.Name),
-		Position:    types.Int64Value(int64(role.Position)),
-		Color:       types.Int64Value(int64(role.Color)),
-		Permissions: types.Int64Value(role.Permissions),
-		Hoist:       types.BoolValue(role.Hoist),
-		Mentionable: types.BoolValue(role.Mentionable),
-		Managed:     types.BoolValue(role.Managed),
+	data = DiscordRoleResourceModel{
+		ID:             types.StringValue(role.ID),
+		ServerID:       data.ServerID,
+		Name:           types.StringValue(role.Name),
+		Position:       types.Int64Value(int64(role.Position)),
+		Color:          types.Int64Value(int64(role.Color)),
+		Permissions:    types.Int64Value(role.Permissions),
+		Hoist:          types.BoolValue(role.Hoist),
+		Mentionable:    types.BoolValue(role.Mentionable),
+		Managed:        types.BoolValue(role.Managed),
+		AuditLogReason: data.AuditLogReason,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DiscordRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state DiscordRoleModel
+	var plan, state DiscordRoleResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -192,7 +217,7 @@ func (r *DiscordRoleResource) Update(ctx context.Context, req resource.UpdateReq
 		if oldRole != nil {
 			param = append(param, &discordgo.Role{ID: oldRole.ID, Position: planPositionInt})
 		}
-		if _, err := client.GuildRoleReorder(state.ServerID.ValueString(), param, discordgo.WithContext(ctx)); err != nil {
+		if _, err := client.GuildRoleReorder(state.ServerID.ValueString(), param, discordgo.WithContext(ctx), utils.WithAuditLogReason(plan.AuditLogReason)); err != nil {
 			resp.Diagnostics.AddError("Failed to re-order roles", err.Error())
 		} else {
 			state.Position = plan.Position
@@ -205,27 +230,28 @@ func (r *DiscordRoleResource) Update(ctx context.Context, req resource.UpdateReq
 		Color:       &roleColor,
 		Hoist:       state.Hoist.ValueBoolPointer(),
 		Mentionable: state.Mentionable.ValueBoolPointer(),
-	})
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(plan.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update role: %s", state.ID.ValueString()), err.Error())
 		return
 	}
-	state = DiscordRoleModel{
-		ID:          types.StringValue(role.ID),
-		ServerID:    state.ServerID,
-		Name:        types.StringValue(role.Name),
-		Position:    types.Int64Value(int64(role.Position)),
-		Color:       types.Int64Value(int64(role.Color)),
-		Permissions: types.Int64Value(role.Permissions),
-		Hoist:       types.BoolValue(role.Hoist),
-		Mentionable: types.BoolValue(role.Mentionable),
-		Managed:     types.BoolValue(role.Managed),
+	state = DiscordRoleResourceModel{
+		ID:             types.StringValue(role.ID),
+		ServerID:       state.ServerID,
+		Name:           types.StringValue(role.Name),
+		Position:       types.Int64Value(int64(role.Position)),
+		Color:          types.Int64Value(int64(role.Color)),
+		Permissions:    types.Int64Value(role.Permissions),
+		Hoist:          types.BoolValue(role.Hoist),
+		Mentionable:    types.BoolValue(role.Mentionable),
+		Managed:        types.BoolValue(role.Managed),
+		AuditLogReason: plan.AuditLogReason,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *DiscordRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DiscordRoleModel
+	var data DiscordRoleResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
@@ -233,7 +259,7 @@ func (r *DiscordRoleResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 	client := r.client.Session
-	err := client.GuildRoleDelete(data.ServerID.ValueString(), data.ID.ValueString(), discordgo.WithContext(ctx))
+	err := client.GuildRoleDelete(data.ServerID.ValueString(), data.ID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete role: %s", data.ID.ValueString()), err.Error())
 		return
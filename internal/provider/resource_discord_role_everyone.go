@@ -9,8 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strings"
 )
@@ -18,6 +16,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DiscordEveryoneRoleResource{}
 var _ resource.ResourceWithImportState = &DiscordEveryoneRoleResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordEveryoneRoleResource{}
 
 func NewDiscordEveryoneRoleResource() resource.Resource {
 	return &DiscordEveryoneRoleResource{}
@@ -28,8 +27,9 @@ type DiscordEveryoneRoleResource struct {
 }
 
 type DiscordEveryoneRoleModel struct {
-	ServerID    types.String `tfsdk:"server_id"`
-	Permissions types.Int64  `tfsdk:"permissions"`
+	ServerID       types.String `tfsdk:"server_id"`
+	Permissions    types.Int64  `tfsdk:"permissions"`
+	AuditLogReason types.String `tfsdk:"audit_log_reason"`
 }
 
 func (r *DiscordEveryoneRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,19 +41,14 @@ func (r *DiscordEveryoneRoleResource) Schema(ctx context.Context, req resource.S
 		MarkdownDescription: "Discord @everyone Role Resource",
 
 		Attributes: map[string]schema.Attribute{
-			"server_id": schema.StringAttribute{
-				MarkdownDescription: "The server ID",
-				Required:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
+			"server_id": utils.ServerIDAttribute(),
 			"permissions": schema.Int64Attribute{
 				MarkdownDescription: "The permissions of the role",
 				Optional:            true,
 				Default:             int64default.StaticInt64(0),
 				Computed:            true,
 			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
 		},
 	}
 }
@@ -77,6 +72,13 @@ func (r *DiscordEveryoneRoleResource) Configure(ctx context.Context, req resourc
 	r.client = client
 }
 
+func (r *DiscordEveryoneRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
 func (r *DiscordEveryoneRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *DiscordEveryoneRoleModel
 
@@ -87,14 +89,18 @@ func (r *DiscordEveryoneRoleResource) Create(ctx context.Context, req resource.C
 	}
 	client := r.client.Session
 	serverID := data.ServerID.ValueString()
-	role, err := utils.GetRole(ctx, client, serverID, serverID)
+	newPermissions := data.Permissions.ValueInt64()
+	role, err := client.GuildRoleEdit(serverID, serverID, &discordgo.RoleParams{
+		Permissions: &newPermissions,
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to everyone role for server %s", serverID), err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update everyone role for server %s", serverID), err.Error())
 		return
 	}
 	data = &DiscordEveryoneRoleModel{
-		ServerID:    data.ServerID,
-		Permissions: types.Int64Value(role.Permissions),
+		ServerID:       data.ServerID,
+		Permissions:    types.Int64Value(role.Permissions),
+		AuditLogReason: data.AuditLogReason,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -110,19 +116,24 @@ func (r *DiscordEveryoneRoleResource) Read(ctx context.Context, req resource.Rea
 	client := r.client.Session
 	serverID := data.ServerID.ValueString()
 	role, err := utils.GetRole(ctx, client, serverID, serverID)
+	if role == nil && (err == nil || utils.IsNotFound(err)) {
+		utils.RemoveNotFound(ctx, resp, "everyone role", serverID)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to everyone role for server %s", serverID), err.Error())
 		return
 	}
 	data = &DiscordEveryoneRoleModel{
-		ServerID:    data.ServerID,
-		Permissions: types.Int64Value(role.Permissions),
+		ServerID:       data.ServerID,
+		Permissions:    types.Int64Value(role.Permissions),
+		AuditLogReason: data.AuditLogReason,
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DiscordEveryoneRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state DiscordRoleModel
+	var plan, state DiscordEveryoneRoleModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -135,26 +146,19 @@ func (r *DiscordEveryoneRoleResource) Update(ctx context.Context, req resource.U
 	newPermissions := plan.Permissions.ValueInt64()
 	role, err := client.GuildRoleEdit(serverID, serverID, &discordgo.RoleParams{
 		Permissions: &newPermissions,
-	})
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(plan.AuditLogReason))
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update everyone role for server %s", state.ID.ValueString()), err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update everyone role for server %s", serverID), err.Error())
 		return
 	}
 	state.Permissions = types.Int64Value(role.Permissions)
+	state.AuditLogReason = plan.AuditLogReason
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *DiscordEveryoneRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DiscordRoleModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.AddError("Cannot delete @everyone role", "Cannot delete @everyone role. If you need to remove the resource then delete it from your state")
-
+	// Every server has an @everyone role, so the resource is only removed from the state. This also lets server_id
+	// change, which replaces the resource.
 }
 
 func (r *DiscordEveryoneRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"testing"
+)
+
+func TestAccResourceDiscordEveryoneRole(t *testing.T) {
+	name := "discord_role_everyone.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordEveryoneRole("first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "server_id", "discord_server.first", "server_id"),
+					resource.TestCheckResourceAttr(name, "permissions", "1024"),
+				),
+			},
+			{
+				// Moving the resource to another server replaces it without deleting the @everyone role.
+				Config: testAccResourceDiscordEveryoneRole("second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "server_id", "discord_server.second", "server_id"),
+					resource.TestCheckResourceAttr(name, "permissions", "1024"),
+				),
+			},
+		},
+	})
+}
+
+// testAccResourceDiscordEveryoneRole has two servers, and sets the permissions of @everyone in one of them.
+func testAccResourceDiscordEveryoneRole(server string) string {
+	return fmt.Sprintf(`
+	resource "discord_server" "first" {
+	  name = "terraform-everyone-first"
+	}
+
+	resource "discord_server" "second" {
+	  name = "terraform-everyone-second"
+	}
+
+	resource "discord_role_everyone" "example" {
+	  server_id = discord_server.%s.server_id
+	  permissions = 1024
+	}`, server)
+}
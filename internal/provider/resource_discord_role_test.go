@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"os"
 	"testing"
 )
@@ -13,6 +14,7 @@ func TestAccResourceDiscordRole(t *testing.T) {
 		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
 	}
 	name := "discord_role.example"
+	var roleID string
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -27,7 +29,30 @@ func TestAccResourceDiscordRole(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "mentionable", "true"),
 					resource.TestCheckResourceAttr(name, "position", "1"),
 					resource.TestCheckResourceAttr(name, "permissions", "1024"),
+					resource.TestCheckResourceAttr(name, "audit_log_reason", "Managed by Terraform"),
 					resource.TestCheckResourceAttrSet(name, "id"),
+					func(s *terraform.State) error {
+						roleID = s.RootModule().Resources[name].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// A role deleted outside Terraform is created again instead of failing the refresh.
+				PreConfig: func() {
+					if err := testAccClient(t).Session.GuildRoleDelete(testServerID, roleID); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccResourceDiscordRole(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[name].Primary.ID; id == roleID {
+							return fmt.Errorf("role %s was not recreated", id)
+						}
+						return nil
+					},
 				),
 			},
 			{
@@ -35,6 +60,8 @@ func TestAccResourceDiscordRole(t *testing.T) {
 				ImportState:         true,
 				ImportStateVerify:   true,
 				ImportStateIdPrefix: fmt.Sprintf("%s/", testServerID),
+				// audit_log_reason only exists in the configuration.
+				ImportStateVerifyIgnore: []string{"audit_log_reason"},
 			},
 		},
 	})
@@ -54,5 +81,6 @@ func testAccResourceDiscordRole(channelID string) string {
   	    mentionable = true
         position = 1
         permissions = 1024
+        audit_log_reason = "Managed by Terraform"
 	}`, channelID)
 }
@@ -0,0 +1,643 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strings"
+	"time"
+)
+
+// scheduledEventEntityTypes are the values of the entity_type attribute.
+var scheduledEventEntityTypes = map[discordgo.GuildScheduledEventEntityType]string{
+	discordgo.GuildScheduledEventEntityTypeStageInstance: "stage_instance",
+	discordgo.GuildScheduledEventEntityTypeVoice:         "voice",
+	discordgo.GuildScheduledEventEntityTypeExternal:      "external",
+}
+
+// scheduledEventStatuses are the values of the status attribute.
+var scheduledEventStatuses = map[discordgo.GuildScheduledEventStatus]string{
+	discordgo.GuildScheduledEventStatusScheduled: "scheduled",
+	discordgo.GuildScheduledEventStatusActive:    "active",
+	discordgo.GuildScheduledEventStatusCompleted: "completed",
+	discordgo.GuildScheduledEventStatusCanceled:  "canceled",
+}
+
+// scheduledEventPrivacyLevels are the values of the privacy_level attribute. Discord only supports guild_only.
+var scheduledEventPrivacyLevels = map[discordgo.GuildScheduledEventPrivacyLevel]string{
+	discordgo.GuildScheduledEventPrivacyLevelGuildOnly: "guild_only",
+}
+
+// scheduledEventFrequencies are the values of the recurrence rule frequency attribute.
+var scheduledEventFrequencies = map[utils.ScheduledEventFrequency]string{
+	utils.ScheduledEventFrequencyYearly:  "yearly",
+	utils.ScheduledEventFrequencyMonthly: "monthly",
+	utils.ScheduledEventFrequencyWeekly:  "weekly",
+	utils.ScheduledEventFrequencyDaily:   "daily",
+}
+
+// scheduledEventWeekdays are the weekday names of recurrence rules, in the order Discord numbers them.
+var scheduledEventWeekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordScheduledEventResource{}
+var _ resource.ResourceWithImportState = &DiscordScheduledEventResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordScheduledEventResource{}
+
+func NewDiscordScheduledEventResource() resource.Resource {
+	return &DiscordScheduledEventResource{}
+}
+
+type DiscordScheduledEventResource struct {
+	client *Context
+}
+
+type DiscordScheduledEventModel struct {
+	ID                 types.String                              `tfsdk:"id"`
+	ServerID           types.String                              `tfsdk:"server_id"`
+	Name               types.String                              `tfsdk:"name"`
+	Description        types.String                              `tfsdk:"description"`
+	EntityType         types.String                              `tfsdk:"entity_type"`
+	ChannelID          types.String                              `tfsdk:"channel_id"`
+	Location           types.String                              `tfsdk:"location"`
+	ScheduledStartTime types.String                              `tfsdk:"scheduled_start_time"`
+	ScheduledEndTime   types.String                              `tfsdk:"scheduled_end_time"`
+	PrivacyLevel       types.String                              `tfsdk:"privacy_level"`
+	Status             types.String                              `tfsdk:"status"`
+	ImageDataURI       types.String                              `tfsdk:"image_data_uri"`
+	ImageHash          types.String                              `tfsdk:"image_hash"`
+	RecurrenceRule     *DiscordScheduledEventRecurrenceRuleModel `tfsdk:"recurrence_rule"`
+	AuditLogReason     types.String                              `tfsdk:"audit_log_reason"`
+}
+
+type DiscordScheduledEventRecurrenceRuleModel struct {
+	Frequency  types.String                         `tfsdk:"frequency"`
+	Interval   types.Int64                          `tfsdk:"interval"`
+	ByWeekday  types.Set                            `tfsdk:"by_weekday"`
+	ByNWeekday []DiscordScheduledEventNWeekdayModel `tfsdk:"by_n_weekday"`
+	ByMonth    types.Set                            `tfsdk:"by_month"`
+	ByMonthDay types.Set                            `tfsdk:"by_month_day"`
+}
+
+type DiscordScheduledEventNWeekdayModel struct {
+	N   types.Int64  `tfsdk:"n"`
+	Day types.String `tfsdk:"day"`
+}
+
+func (r *DiscordScheduledEventResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_event"
+}
+
+func (r *DiscordScheduledEventResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Scheduled Event Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the scheduled event",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": utils.ServerIDAttribute(),
+			"name": schema.StringAttribute{
+				Description: "The name of the scheduled event",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the scheduled event",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1000),
+				},
+			},
+			"entity_type": schema.StringAttribute{
+				Description: "Where the scheduled event takes place. One of `stage_instance`, `voice` and `external`",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("stage_instance", "voice", "external"),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the stage or voice channel of the scheduled event. Required for `stage_instance` and `voice` events",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("location")),
+				},
+			},
+			"location": schema.StringAttribute{
+				Description: "Where the scheduled event takes place. Required for `external` events",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"scheduled_start_time": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp of when the scheduled event starts. It must be in the future when the event is created",
+				Required:    true,
+			},
+			"scheduled_end_time": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp of when the scheduled event ends. Required for `external` events",
+				Optional:    true,
+			},
+			"privacy_level": schema.StringAttribute{
+				Description: "Who can see the scheduled event. Discord only supports `guild_only`",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("guild_only"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("guild_only"),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the scheduled event. One of `scheduled`, `active`, `completed` and `canceled`. A scheduled event can become active or canceled, and an active one completed. Completed and canceled events cannot be changed",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("scheduled", "active", "completed", "canceled"),
+				},
+			},
+			"image_data_uri": schema.StringAttribute{
+				Description: "The cover image of the scheduled event as a data URI, such as the one of the `discord_local_image` data source",
+				Optional:    true,
+			},
+			"image_hash": schema.StringAttribute{
+				Description: "The Discord hash of the cover image",
+				Computed:    true,
+			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"recurrence_rule": schema.SingleNestedBlock{
+				Description: "How the scheduled event repeats. It starts at `scheduled_start_time`. Discord only supports some rules: `daily` events on a set of weekdays, `weekly` events on one weekday every one or two weeks, `monthly` events on one `by_n_weekday` and `yearly` events on one `by_month` and `by_month_day`",
+				// Attributes of a single nested block cannot be required, as they would be when the block is absent.
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("frequency")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"frequency": schema.StringAttribute{
+						Description: "How often the scheduled event repeats. One of `daily`, `weekly`, `monthly` and `yearly`",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("daily", "weekly", "monthly", "yearly"),
+						},
+					},
+					"interval": schema.Int64Attribute{
+						Description: "The number of `frequency` periods between occurrences. Defaults to 1",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"by_weekday": schema.SetAttribute{
+						Description: "The weekdays the scheduled event happens on, such as `monday`",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(scheduledEventWeekdays...)),
+						},
+					},
+					"by_month": schema.SetAttribute{
+						Description: "The months the scheduled event happens in, from 1 for January to 12",
+						ElementType: types.Int64Type,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.ValueInt64sAre(int64validator.Between(1, 12)),
+						},
+					},
+					"by_month_day": schema.SetAttribute{
+						Description: "The days of the month the scheduled event happens on, from 1 to 31",
+						ElementType: types.Int64Type,
+						Optional:    true,
+						Validators: []validator.Set{
+							setvalidator.ValueInt64sAre(int64validator.Between(1, 31)),
+						},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"by_n_weekday": schema.ListNestedBlock{
+						Description: "A weekday of a week of the month the scheduled event happens on, such as the second Tuesday",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"n": schema.Int64Attribute{
+									Description: "The week of the month, from 1 to 5",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64validator.Between(1, 5),
+									},
+								},
+								"day": schema.StringAttribute{
+									Description: "The weekday, such as `tuesday`",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(scheduledEventWeekdays...),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DiscordScheduledEventResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DiscordScheduledEventResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+}
+
+func (r *DiscordScheduledEventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordScheduledEventModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params, diags := buildScheduledEventParams(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	steps, diags := scheduledEventStatusSteps("scheduled", data.Status)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if image := data.ImageDataURI.ValueString(); image != "" {
+		params.Image = &image
+	}
+
+	serverID := data.ServerID.ValueString()
+	event, err := utils.ScheduledEventCreateComplex(r.client.Session, serverID, params, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create scheduled event", err.Error())
+		return
+	}
+	// Discord creates every event as scheduled, so the configured status is reached with edits.
+	params.Image = nil
+	for _, status := range steps {
+		params.Status = status
+		event, err = utils.ScheduledEventEditComplex(r.client.Session, serverID, event.ID, params, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to set the status of scheduled event %s", event.ID), err.Error())
+			return
+		}
+	}
+
+	model, diags := buildScheduledEventModel(ctx, serverID, event, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordScheduledEventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordScheduledEventModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	event, err := utils.GetScheduledEvent(r.client.Session, serverID, data.ID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "scheduled event", data.ID.ValueString())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get scheduled event %s", data.ID.ValueString()), err.Error())
+		return
+	}
+
+	model, diags := buildScheduledEventModel(ctx, serverID, event, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordScheduledEventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DiscordScheduledEventModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params, diags := buildScheduledEventParams(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	steps, diags := scheduledEventStatusSteps(state.Status.ValueString(), data.Status)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The cover image is only sent when it changes, as an empty string when it is removed.
+	if !data.ImageDataURI.Equal(state.ImageDataURI) {
+		image := data.ImageDataURI.ValueString()
+		params.Image = &image
+	}
+
+	serverID := data.ServerID.ValueString()
+	if len(steps) == 0 {
+		steps = []discordgo.GuildScheduledEventStatus{0}
+	}
+	var event *utils.ScheduledEvent
+	for _, status := range steps {
+		var err error
+		params.Status = status
+		event, err = utils.ScheduledEventEditComplex(r.client.Session, serverID, data.ID.ValueString(), params, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update scheduled event %s", data.ID.ValueString()), err.Error())
+			return
+		}
+		params.Image = nil
+	}
+
+	model, diags := buildScheduledEventModel(ctx, serverID, event, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordScheduledEventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordScheduledEventModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.Session.GuildScheduledEventDelete(data.ServerID.ValueString(), data.ID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete scheduled event %s", data.ID.ValueString()), err.Error())
+		return
+	}
+}
+
+func (r *DiscordScheduledEventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverID, eventID, ok := strings.Cut(req.ID, ":")
+	if !ok || serverID == "" || eventID == "" {
+		resp.Diagnostics.AddError("error importing Discord Scheduled Event", "invalid ID specified. Please specify the ID as \"server_id:event_id\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), eventID)...)
+}
+
+// scheduledEventStatusSteps returns the statuses to set, in order, to go from one status to another. Discord does not
+// allow a scheduled event to be completed without being active first.
+func scheduledEventStatusSteps(from string, to types.String) ([]discordgo.GuildScheduledEventStatus, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if to.IsNull() || to.IsUnknown() || to.ValueString() == from {
+		return nil, diags
+	}
+	switch from + ":" + to.ValueString() {
+	case "scheduled:active":
+		return []discordgo.GuildScheduledEventStatus{discordgo.GuildScheduledEventStatusActive}, diags
+	case "scheduled:completed":
+		return []discordgo.GuildScheduledEventStatus{discordgo.GuildScheduledEventStatusActive, discordgo.GuildScheduledEventStatusCompleted}, diags
+	case "scheduled:canceled":
+		return []discordgo.GuildScheduledEventStatus{discordgo.GuildScheduledEventStatusCanceled}, diags
+	case "active:completed":
+		return []discordgo.GuildScheduledEventStatus{discordgo.GuildScheduledEventStatusCompleted}, diags
+	}
+	diags.AddAttributeError(path.Root("status"), "Invalid status", fmt.Sprintf("The status of a scheduled event cannot change from %s to %s.", from, to.ValueString()))
+
+	return nil, diags
+}
+
+// buildScheduledEventParams checks that the fields required by the entity type are set and returns the body to send.
+// The status and cover image are left to the caller.
+func buildScheduledEventParams(ctx context.Context, data DiscordScheduledEventModel) (*utils.ScheduledEventParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	params := &utils.ScheduledEventParams{
+		Name:         data.Name.ValueString(),
+		Description:  data.Description.ValueStringPointer(),
+		PrivacyLevel: discordgo.GuildScheduledEventPrivacyLevelGuildOnly,
+	}
+	for entityType, name := range scheduledEventEntityTypes {
+		if name == data.EntityType.ValueString() {
+			params.EntityType = entityType
+		}
+	}
+
+	if params.EntityType == discordgo.GuildScheduledEventEntityTypeExternal {
+		if data.Location.IsNull() {
+			diags.AddAttributeError(path.Root("location"), "Missing location", "location is required for external scheduled events.")
+		}
+		if data.ScheduledEndTime.IsNull() {
+			diags.AddAttributeError(path.Root("scheduled_end_time"), "Missing end time", "scheduled_end_time is required for external scheduled events.")
+		}
+		if !data.ChannelID.IsNull() {
+			diags.AddAttributeError(path.Root("channel_id"), "Unexpected channel", "External scheduled events cannot have a channel_id.")
+		}
+		params.EntityMetadata = &discordgo.GuildScheduledEventEntityMetadata{Location: data.Location.ValueString()}
+	} else {
+		if data.ChannelID.IsNull() {
+			diags.AddAttributeError(path.Root("channel_id"), "Missing channel", fmt.Sprintf("channel_id is required for %s scheduled events.", data.EntityType.ValueString()))
+		}
+		if !data.Location.IsNull() {
+			diags.AddAttributeError(path.Root("location"), "Unexpected location", "Only external scheduled events can have a location.")
+		}
+		params.ChannelID = data.ChannelID.ValueStringPointer()
+	}
+
+	start, err := time.Parse(time.RFC3339, data.ScheduledStartTime.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("scheduled_start_time"), "Invalid start time", fmt.Sprintf("%q is not an RFC 3339 timestamp.", data.ScheduledStartTime.ValueString()))
+	}
+	params.ScheduledStartTime = start.UTC()
+	if !data.ScheduledEndTime.IsNull() {
+		end, err := time.Parse(time.RFC3339, data.ScheduledEndTime.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("scheduled_end_time"), "Invalid end time", fmt.Sprintf("%q is not an RFC 3339 timestamp.", data.ScheduledEndTime.ValueString()))
+		}
+		end = end.UTC()
+		params.ScheduledEndTime = &end
+	}
+
+	if rule := data.RecurrenceRule; rule != nil {
+		recurrence := &utils.ScheduledEventRecurrenceRule{
+			Start:      params.ScheduledStartTime,
+			Interval:   1,
+			ByWeekday:  []int{},
+			ByNWeekday: []utils.ScheduledEventNWeekday{},
+		}
+		for frequency, name := range scheduledEventFrequencies {
+			if name == rule.Frequency.ValueString() {
+				recurrence.Frequency = frequency
+			}
+		}
+		if !rule.Interval.IsNull() && !rule.Interval.IsUnknown() {
+			recurrence.Interval = int(rule.Interval.ValueInt64())
+		}
+		var weekdays []string
+		diags.Append(rule.ByWeekday.ElementsAs(ctx, &weekdays, false)...)
+		for _, weekday := range weekdays {
+			recurrence.ByWeekday = append(recurrence.ByWeekday, scheduledEventWeekday(weekday))
+		}
+		sort.Ints(recurrence.ByWeekday)
+		for _, nWeekday := range rule.ByNWeekday {
+			recurrence.ByNWeekday = append(recurrence.ByNWeekday, utils.ScheduledEventNWeekday{
+				N:   int(nWeekday.N.ValueInt64()),
+				Day: scheduledEventWeekday(nWeekday.Day.ValueString()),
+			})
+		}
+		diags.Append(rule.ByMonth.ElementsAs(ctx, &recurrence.ByMonth, false)...)
+		diags.Append(rule.ByMonthDay.ElementsAs(ctx, &recurrence.ByMonthDay, false)...)
+		sort.Ints(recurrence.ByMonth)
+		sort.Ints(recurrence.ByMonthDay)
+		params.RecurrenceRule = recurrence
+	}
+
+	return params, diags
+}
+
+// scheduledEventWeekday returns the number Discord uses for a weekday name.
+func scheduledEventWeekday(name string) int {
+	for day, weekday := range scheduledEventWeekdays {
+		if weekday == name {
+			return day
+		}
+	}
+
+	return 0
+}
+
+// buildScheduledEventModel returns the scheduled event as the resource sees it. Times are kept as configured when
+// they are the same instant written differently.
+func buildScheduledEventModel(ctx context.Context, serverID string, event *utils.ScheduledEvent, data DiscordScheduledEventModel) (*DiscordScheduledEventModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	start := event.ScheduledStartTime.UTC().Format(time.RFC3339)
+	scheduledStartTime := types.StringValue(start)
+	if sameTimestamp(data.ScheduledStartTime.ValueString(), start) {
+		scheduledStartTime = data.ScheduledStartTime
+	}
+	scheduledEndTime := types.StringNull()
+	if event.ScheduledEndTime != nil {
+		end := event.ScheduledEndTime.UTC().Format(time.RFC3339)
+		scheduledEndTime = types.StringValue(end)
+		if sameTimestamp(data.ScheduledEndTime.ValueString(), end) {
+			scheduledEndTime = data.ScheduledEndTime
+		}
+	}
+	imageDataURI := data.ImageDataURI
+	if imageDataURI.IsUnknown() {
+		imageDataURI = types.StringNull()
+	}
+
+	model := &DiscordScheduledEventModel{
+		ID:                 types.StringValue(event.ID),
+		ServerID:           types.StringValue(serverID),
+		Name:               types.StringValue(event.Name),
+		Description:        utils.StringValueOrNull(event.Description),
+		EntityType:         types.StringValue(scheduledEventEntityTypes[event.EntityType]),
+		ChannelID:          utils.StringValueOrNull(event.ChannelID),
+		Location:           utils.StringValueOrNull(event.EntityMetadata.Location),
+		ScheduledStartTime: scheduledStartTime,
+		ScheduledEndTime:   scheduledEndTime,
+		PrivacyLevel:       types.StringValue(scheduledEventPrivacyLevels[event.PrivacyLevel]),
+		Status:             types.StringValue(scheduledEventStatuses[event.Status]),
+		ImageDataURI:       imageDataURI,
+		ImageHash:          utils.StringValueOrNull(event.Image),
+		AuditLogReason:     data.AuditLogReason,
+	}
+
+	if rule := event.RecurrenceRule; rule != nil {
+		recurrence := &DiscordScheduledEventRecurrenceRuleModel{
+			Frequency: types.StringValue(scheduledEventFrequencies[rule.Frequency]),
+			Interval:  types.Int64Value(int64(rule.Interval)),
+		}
+		var d diag.Diagnostics
+		recurrence.ByWeekday, d = scheduledEventWeekdaySet(rule.ByWeekday)
+		diags.Append(d...)
+		for _, nWeekday := range rule.ByNWeekday {
+			recurrence.ByNWeekday = append(recurrence.ByNWeekday, DiscordScheduledEventNWeekdayModel{
+				N:   types.Int64Value(int64(nWeekday.N)),
+				Day: types.StringValue(scheduledEventWeekdays[nWeekday.Day%len(scheduledEventWeekdays)]),
+			})
+		}
+		recurrence.ByMonth, d = scheduledEventInt64Set(ctx, rule.ByMonth)
+		diags.Append(d...)
+		recurrence.ByMonthDay, d = scheduledEventInt64Set(ctx, rule.ByMonthDay)
+		diags.Append(d...)
+		model.RecurrenceRule = recurrence
+	}
+
+	return model, diags
+}
+
+// scheduledEventWeekdaySet returns the weekday names of a recurrence rule, or null when it has none.
+func scheduledEventWeekdaySet(days []int) (types.Set, diag.Diagnostics) {
+	if len(days) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+	weekdays := make([]attr.Value, 0, len(days))
+	for _, day := range days {
+		weekdays = append(weekdays, types.StringValue(scheduledEventWeekdays[day%len(scheduledEventWeekdays)]))
+	}
+
+	return types.SetValue(types.StringType, weekdays)
+}
+
+// scheduledEventInt64Set returns the months or days of a recurrence rule, or null when it has none.
+func scheduledEventInt64Set(ctx context.Context, values []int) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.SetNull(types.Int64Type), nil
+	}
+
+	return types.SetValueFrom(ctx, types.Int64Type, values)
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"image/color"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAccResourceDiscordScheduledEvent(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	start := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	end := start.Add(2 * time.Hour)
+	weekday := strings.ToLower(start.Weekday().String())
+	cover := utils.ImageDataURI("image/png", testAccPNG(t, 64, color.RGBA{B: 0xff, A: 0xff}))
+	name := "discord_scheduled_event.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceDiscordScheduledEvent(testServerID, "terraform-event", fmt.Sprintf("entity_type = \"external\"\n  scheduled_start_time = %q", start.Format(time.RFC3339))),
+				ExpectError: regexp.MustCompile("location is required for external scheduled events"),
+			},
+			{
+				Config: testAccResourceDiscordScheduledEvent(testServerID, "terraform-event", fmt.Sprintf(`entity_type = "voice"
+  channel_id = discord_voice_channel.example.channel_id
+  description = "Weekly sync"
+  scheduled_start_time = %q
+  image_data_uri = %q
+  recurrence_rule {
+    frequency = "weekly"
+    by_weekday = [%q]
+  }`, start.Format(time.RFC3339), cover, weekday)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "terraform-event"),
+					resource.TestCheckResourceAttr(name, "description", "Weekly sync"),
+					resource.TestCheckResourceAttr(name, "entity_type", "voice"),
+					resource.TestCheckResourceAttrPair(name, "channel_id", "discord_voice_channel.example", "channel_id"),
+					resource.TestCheckResourceAttr(name, "scheduled_start_time", start.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(name, "privacy_level", "guild_only"),
+					resource.TestCheckResourceAttr(name, "status", "scheduled"),
+					resource.TestCheckResourceAttrSet(name, "image_hash"),
+					resource.TestCheckResourceAttr(name, "recurrence_rule.frequency", "weekly"),
+					resource.TestCheckResourceAttr(name, "recurrence_rule.interval", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "recurrence_rule.by_weekday.*", weekday),
+				),
+			},
+			{
+				Config: testAccResourceDiscordScheduledEvent(testServerID, "terraform-external-event", fmt.Sprintf(`entity_type = "external"
+  location = "https://example.com/stream"
+  scheduled_start_time = %q
+  scheduled_end_time = %q`, start.Format(time.RFC3339), end.Format(time.RFC3339))),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "terraform-external-event"),
+					resource.TestCheckResourceAttr(name, "entity_type", "external"),
+					resource.TestCheckResourceAttr(name, "location", "https://example.com/stream"),
+					resource.TestCheckNoResourceAttr(name, "channel_id"),
+					resource.TestCheckNoResourceAttr(name, "description"),
+					resource.TestCheckResourceAttr(name, "scheduled_end_time", end.Format(time.RFC3339)),
+					resource.TestCheckNoResourceAttr(name, "image_hash"),
+					resource.TestCheckNoResourceAttr(name, "recurrence_rule.frequency"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordScheduledEvent(testServerID, "terraform-external-event", fmt.Sprintf(`entity_type = "external"
+  location = "https://example.com/stream"
+  scheduled_start_time = %q
+  scheduled_end_time = %q
+  status = "active"`, start.Format(time.RFC3339), end.Format(time.RFC3339))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "status", "active"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s:", testServerID),
+				ImportStateVerify:   true,
+				// The cover image can only be read back as a hash.
+				ImportStateVerifyIgnore: []string{"audit_log_reason", "image_data_uri"},
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordScheduledEvent(serverID string, name string, event string) string {
+	return fmt.Sprintf(`
+	resource "discord_voice_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-event-voice"
+	  bitrate = 64000
+	  user_limit = 4
+	  sync_perms_with_category = false
+	}
+
+	resource "discord_scheduled_event" "example" {
+	  server_id = "%[1]s"
+	  name = "%[2]s"
+	  %[3]s
+	  audit_log_reason = "Managed by Terraform"
+	}`, serverID, name, event)
+}
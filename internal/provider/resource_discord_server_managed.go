@@ -53,19 +53,19 @@ func (r *DiscordManagedServerResource) Configure(ctx context.Context, req resour
 }
 
 func (r *DiscordManagedServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	utils.DiscordServerCreate(r.client.Session, ctx, req, resp)
+	utils.DiscordServerCreate(r.client.Session, ctx, req, resp, true)
 }
 
 func (r *DiscordManagedServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	utils.DiscordServerRead(r.client.Session, ctx, req, resp)
+	utils.DiscordServerRead(r.client.Session, ctx, req, resp, true)
 }
 
 func (r *DiscordManagedServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	utils.DiscordServerUpdate(r.client.Session, ctx, req, resp)
+	utils.DiscordServerUpdate(r.client.Session, ctx, req, resp, true)
 }
 
 func (r *DiscordManagedServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	utils.DiscordServerDelete(r.client.Session, ctx, req, resp)
+	utils.DiscordServerDelete(r.client.Session, ctx, req, resp, true)
 }
 
 func (r *DiscordManagedServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
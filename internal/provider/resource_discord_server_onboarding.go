@@ -0,0 +1,708 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"time"
+)
+
+// onboardingModes are the values of the mode attribute.
+var onboardingModes = map[discordgo.GuildOnboardingMode]string{
+	discordgo.GuildOnboardingModeDefault:  "default",
+	discordgo.GuildOnboardingModeAdvanced: "advanced",
+}
+
+// onboardingPromptTypes are the values of the prompt type attribute.
+var onboardingPromptTypes = map[discordgo.GuildOnboardingPromptType]string{
+	discordgo.GuildOnboardingPromptTypeMultipleChoice: "multiple_choice",
+	discordgo.GuildOnboardingPromptTypeDropdown:       "dropdown",
+}
+
+// Discord needs enabled onboarding to have at least onboardingMinChannels channels, and @everyone to be able to
+// send messages in onboardingMinSendableChannels of them.
+const (
+	onboardingMinChannels         = 7
+	onboardingMinSendableChannels = 5
+)
+
+// discordEpoch is the first millisecond of 2015 in Unix milliseconds, where the timestamps of snowflakes start.
+const discordEpoch = 1420070400000
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordServerOnboardingResource{}
+var _ resource.ResourceWithImportState = &DiscordServerOnboardingResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordServerOnboardingResource{}
+var _ resource.ResourceWithValidateConfig = &DiscordServerOnboardingResource{}
+
+func NewDiscordServerOnboardingResource() resource.Resource {
+	return &DiscordServerOnboardingResource{}
+}
+
+type DiscordServerOnboardingResource struct {
+	client *Context
+}
+
+type DiscordServerOnboardingModel struct {
+	ServerID          types.String                         `tfsdk:"server_id"`
+	Enabled           types.Bool                           `tfsdk:"enabled"`
+	Mode              types.String                         `tfsdk:"mode"`
+	DefaultChannelIDs types.Set                            `tfsdk:"default_channel_ids"`
+	Prompt            []DiscordServerOnboardingPromptModel `tfsdk:"prompt"`
+	AuditLogReason    types.String                         `tfsdk:"audit_log_reason"`
+}
+
+type DiscordServerOnboardingPromptModel struct {
+	ID           types.String                         `tfsdk:"id"`
+	Type         types.String                         `tfsdk:"type"`
+	Title        types.String                         `tfsdk:"title"`
+	SingleSelect types.Bool                           `tfsdk:"single_select"`
+	Required     types.Bool                           `tfsdk:"required"`
+	InOnboarding types.Bool                           `tfsdk:"in_onboarding"`
+	Option       []DiscordServerOnboardingOptionModel `tfsdk:"option"`
+}
+
+type DiscordServerOnboardingOptionModel struct {
+	ID           types.String `tfsdk:"id"`
+	Title        types.String `tfsdk:"title"`
+	Description  types.String `tfsdk:"description"`
+	RoleIDs      types.Set    `tfsdk:"role_ids"`
+	ChannelIDs   types.Set    `tfsdk:"channel_ids"`
+	EmojiID      types.String `tfsdk:"emoji_id"`
+	EmojiUnicode types.String `tfsdk:"emoji_unicode"`
+}
+
+func (r *DiscordServerOnboardingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_onboarding"
+}
+
+func (r *DiscordServerOnboardingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Server Onboarding Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"server_id": utils.ServerIDAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Whether new members go through onboarding",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"mode": schema.StringAttribute{
+				Description: "Which channels count towards the channel requirements of enabled onboarding. `default` counts the default channels, `advanced` also counts the channels of the prompt options",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("default"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "advanced"),
+				},
+			},
+			"default_channel_ids": schema.SetAttribute{
+				Description: "The IDs of the channels new members are added to. Enabled onboarding needs at least 7 channels, and @everyone must be able to send messages in 5 of them",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"prompt": schema.ListNestedBlock{
+				Description: "A question asked to new members, at most 15",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(15),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the prompt",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "How the options are shown. One of `multiple_choice` and `dropdown`",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("multiple_choice", "dropdown"),
+							},
+						},
+						"title": schema.StringAttribute{
+							Description: "The question",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 100),
+							},
+						},
+						"single_select": schema.BoolAttribute{
+							Description: "Whether members can only pick one option",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"required": schema.BoolAttribute{
+							Description: "Whether members must answer the prompt to finish onboarding",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"in_onboarding": schema.BoolAttribute{
+							Description: "Whether the prompt is asked during onboarding. Otherwise it is only shown in Channels & Roles",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"option": schema.ListNestedBlock{
+							Description: "An answer to the prompt, at most 50. Each option must grant roles or channels",
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeBetween(1, 50),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "The ID of the option",
+										Computed:    true,
+									},
+									"title": schema.StringAttribute{
+										Description: "The answer",
+										Required:    true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 50),
+										},
+									},
+									"description": schema.StringAttribute{
+										Description: "What the option is for",
+										Optional:    true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 100),
+										},
+									},
+									"role_ids": schema.SetAttribute{
+										Description: "The IDs of the roles members get when picking the option",
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"channel_ids": schema.SetAttribute{
+										Description: "The IDs of the channels members are added to when picking the option",
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"emoji_id": schema.StringAttribute{
+										Description: "The ID of a custom emoji shown next to the option",
+										Optional:    true,
+										Validators: []validator.String{
+											stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("emoji_unicode")),
+										},
+									},
+									"emoji_unicode": schema.StringAttribute{
+										Description: "A unicode emoji shown next to the option",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DiscordServerOnboardingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that every option grants something, and that enabled onboarding has enough channels.
+func (r *DiscordServerOnboardingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DiscordServerOnboardingModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, prompt := range data.Prompt {
+		for j, option := range prompt.Option {
+			if option.RoleIDs.IsUnknown() || option.ChannelIDs.IsUnknown() {
+				continue
+			}
+			if len(option.RoleIDs.Elements())+len(option.ChannelIDs.Elements()) == 0 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("prompt").AtListIndex(i).AtName("option").AtListIndex(j),
+					"Invalid onboarding option",
+					"Options must grant at least one role or channel, with role_ids or channel_ids.",
+				)
+			}
+		}
+	}
+
+	if data.Enabled.ValueBool() || data.Enabled.IsNull() {
+		channelIDs, known := onboardingChannelIDs(data)
+		if known && len(channelIDs) < onboardingMinChannels {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("default_channel_ids"),
+				"Not enough onboarding channels",
+				fmt.Sprintf("Enabled onboarding needs at least %d channels in %s mode, got %d.", onboardingMinChannels, onboardingModeName(data.Mode), len(channelIDs)),
+			)
+		}
+	}
+}
+
+// ModifyPlan keeps the IDs of existing prompts and options, checks that the channels are channels of the server, and
+// that @everyone can send messages in enough of them for enabled onboarding.
+func (r *DiscordServerOnboardingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan DiscordServerOnboardingModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state DiscordServerOnboardingModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		matchOnboardingIDs(&plan, state)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if plan.ServerID.IsUnknown() {
+		return
+	}
+	channelIDs, known := onboardingChannelIDs(plan)
+	if !known || len(channelIDs) == 0 {
+		return
+	}
+
+	serverID := plan.ServerID.ValueString()
+	channels, err := r.client.Session.GuildChannels(serverID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get channels of server %s", serverID), err.Error())
+		return
+	}
+	roles, err := r.client.Session.GuildRoles(serverID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get roles of server %s", serverID), err.Error())
+		return
+	}
+	var everyone int64
+	for _, role := range roles {
+		if role.ID == serverID {
+			everyone = role.Permissions
+		}
+	}
+	serverChannels := make(map[string]*discordgo.Channel, len(channels))
+	for _, channel := range channels {
+		serverChannels[channel.ID] = channel
+	}
+
+	for _, channels := range onboardingChannelSets(plan) {
+		for _, element := range channels.set.Elements() {
+			channelID := element.(types.String).ValueString()
+			if _, ok := serverChannels[channelID]; !ok {
+				resp.Diagnostics.AddAttributeError(
+					channels.path,
+					"Invalid onboarding channel",
+					fmt.Sprintf("Channel %s is not a channel of server %s.", channelID, serverID),
+				)
+			}
+		}
+	}
+	sendable := 0
+	for _, channelID := range channelIDs {
+		if channel, ok := serverChannels[channelID]; ok && everyoneCanSendMessages(serverID, everyone, channel) {
+			sendable++
+		}
+	}
+	if !resp.Diagnostics.HasError() && plan.Enabled.ValueBool() && sendable < onboardingMinSendableChannels {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_channel_ids"),
+			"Not enough onboarding channels",
+			fmt.Sprintf("Enabled onboarding needs @everyone to be able to send messages in at least %d channels, got %d.", onboardingMinSendableChannels, sendable),
+		)
+	}
+}
+
+func (r *DiscordServerOnboardingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordServerOnboardingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params, diags := buildServerOnboardingParams(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := data.ServerID.ValueString()
+	onboarding, err := r.client.Session.GuildOnboardingEdit(serverID, params, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update onboarding of server %s", serverID), err.Error())
+		return
+	}
+
+	model, diags := buildServerOnboardingModel(ctx, serverID, onboarding, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordServerOnboardingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordServerOnboardingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	onboarding, err := r.client.Session.GuildOnboarding(serverID, discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "server", serverID)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get onboarding of server %s", serverID), err.Error())
+		return
+	}
+
+	model, diags := buildServerOnboardingModel(ctx, serverID, onboarding, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordServerOnboardingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DiscordServerOnboardingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params, diags := buildServerOnboardingParams(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := data.ServerID.ValueString()
+	onboarding, err := r.client.Session.GuildOnboardingEdit(serverID, params, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update onboarding of server %s", serverID), err.Error())
+		return
+	}
+
+	model, diags := buildServerOnboardingModel(ctx, serverID, onboarding, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Delete disables onboarding. Discord keeps the prompts and default channels.
+func (r *DiscordServerOnboardingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordServerOnboardingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	enabled := false
+	if _, err := r.client.Session.GuildOnboardingEdit(data.ServerID.ValueString(), &discordgo.GuildOnboarding{Enabled: &enabled}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to disable onboarding of server %s", data.ServerID.ValueString()), err.Error())
+		return
+	}
+}
+
+func (r *DiscordServerOnboardingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("server_id"), req, resp)
+}
+
+// buildServerOnboardingParams returns the onboarding to send. New prompts and options are sent with placeholder IDs.
+func buildServerOnboardingParams(ctx context.Context, data DiscordServerOnboardingModel) (*discordgo.GuildOnboarding, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ids := newOnboardingIDs()
+	enabled := data.Enabled.ValueBool()
+	mode := discordgo.GuildOnboardingModeDefault
+	for value, name := range onboardingModes {
+		if name == data.Mode.ValueString() {
+			mode = value
+		}
+	}
+	params := &discordgo.GuildOnboarding{Enabled: &enabled, Mode: &mode}
+	diags.Append(data.DefaultChannelIDs.ElementsAs(ctx, &params.DefaultChannelIDs, false)...)
+
+	prompts := []discordgo.GuildOnboardingPrompt{}
+	for _, prompt := range data.Prompt {
+		onboardingPrompt := discordgo.GuildOnboardingPrompt{
+			ID:           ids.id(prompt.ID),
+			Title:        prompt.Title.ValueString(),
+			SingleSelect: prompt.SingleSelect.ValueBool(),
+			Required:     prompt.Required.ValueBool(),
+			InOnboarding: prompt.InOnboarding.ValueBool(),
+		}
+		for promptType, name := range onboardingPromptTypes {
+			if name == prompt.Type.ValueString() {
+				onboardingPrompt.Type = promptType
+			}
+		}
+		for _, option := range prompt.Option {
+			promptOption := discordgo.GuildOnboardingPromptOption{
+				ID:          ids.id(option.ID),
+				Title:       option.Title.ValueString(),
+				Description: option.Description.ValueString(),
+				EmojiID:     option.EmojiID.ValueString(),
+				EmojiName:   option.EmojiUnicode.ValueString(),
+				RoleIDs:     []string{},
+				ChannelIDs:  []string{},
+			}
+			diags.Append(option.RoleIDs.ElementsAs(ctx, &promptOption.RoleIDs, false)...)
+			diags.Append(option.ChannelIDs.ElementsAs(ctx, &promptOption.ChannelIDs, false)...)
+			onboardingPrompt.Options = append(onboardingPrompt.Options, promptOption)
+		}
+		prompts = append(prompts, onboardingPrompt)
+	}
+	params.Prompts = &prompts
+
+	return params, diags
+}
+
+func buildServerOnboardingModel(ctx context.Context, serverID string, onboarding *discordgo.GuildOnboarding, data DiscordServerOnboardingModel) (*DiscordServerOnboardingModel, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+	model := &DiscordServerOnboardingModel{
+		ServerID:       types.StringValue(serverID),
+		Enabled:        types.BoolValue(onboarding.Enabled != nil && *onboarding.Enabled),
+		Mode:           types.StringValue(onboardingModes[discordgo.GuildOnboardingModeDefault]),
+		AuditLogReason: data.AuditLogReason,
+	}
+	if onboarding.Mode != nil {
+		model.Mode = types.StringValue(onboardingModes[*onboarding.Mode])
+	}
+	model.DefaultChannelIDs, d = types.SetValueFrom(ctx, types.StringType, onboarding.DefaultChannelIDs)
+	diags.Append(d...)
+	if onboarding.Prompts == nil {
+		return model, diags
+	}
+
+	for i, prompt := range *onboarding.Prompts {
+		promptModel := DiscordServerOnboardingPromptModel{
+			ID:           types.StringValue(prompt.ID),
+			Type:         types.StringValue(onboardingPromptTypes[prompt.Type]),
+			Title:        types.StringValue(prompt.Title),
+			SingleSelect: types.BoolValue(prompt.SingleSelect),
+			Required:     types.BoolValue(prompt.Required),
+			InOnboarding: types.BoolValue(prompt.InOnboarding),
+		}
+		var configured []DiscordServerOnboardingOptionModel
+		if i < len(data.Prompt) {
+			configured = data.Prompt[i].Option
+		}
+		for j, option := range prompt.Options {
+			configuredOption := DiscordServerOnboardingOptionModel{
+				RoleIDs:    types.SetNull(types.StringType),
+				ChannelIDs: types.SetNull(types.StringType),
+			}
+			if j < len(configured) {
+				configuredOption = configured[j]
+			}
+			optionModel := DiscordServerOnboardingOptionModel{
+				ID:           types.StringValue(option.ID),
+				Title:        types.StringValue(option.Title),
+				Description:  utils.StringValueOrNull(option.Description),
+				EmojiID:      types.StringNull(),
+				EmojiUnicode: types.StringNull(),
+			}
+			optionModel.RoleIDs, d = utils.StringSetOrNull(ctx, option.RoleIDs, configuredOption.RoleIDs)
+			diags.Append(d...)
+			optionModel.ChannelIDs, d = utils.StringSetOrNull(ctx, option.ChannelIDs, configuredOption.ChannelIDs)
+			diags.Append(d...)
+			if option.Emoji != nil && option.Emoji.ID != "" {
+				optionModel.EmojiID = types.StringValue(option.Emoji.ID)
+			} else if option.Emoji != nil {
+				optionModel.EmojiUnicode = utils.StringValueOrNull(option.Emoji.Name)
+			}
+			promptModel.Option = append(promptModel.Option, optionModel)
+		}
+		model.Prompt = append(model.Prompt, promptModel)
+	}
+
+	return model, diags
+}
+
+// matchOnboardingIDs gives the planned prompts the IDs of the prompts in state with the same title, and their options
+// the IDs of the options with the same title. Matching by position would make Discord edit the wrong prompt when
+// prompts are inserted or reordered. Prompts and options without a match are new.
+func matchOnboardingIDs(plan *DiscordServerOnboardingModel, state DiscordServerOnboardingModel) {
+	matched := map[int]bool{}
+	for i := range plan.Prompt {
+		prompt := &plan.Prompt[i]
+		prompt.ID = types.StringUnknown()
+		var stateOptions []DiscordServerOnboardingOptionModel
+		for j, statePrompt := range state.Prompt {
+			if !matched[j] && statePrompt.Title.Equal(prompt.Title) {
+				matched[j] = true
+				prompt.ID = statePrompt.ID
+				stateOptions = statePrompt.Option
+				break
+			}
+		}
+
+		matchedOptions := map[int]bool{}
+		for j := range prompt.Option {
+			option := &prompt.Option[j]
+			option.ID = types.StringUnknown()
+			for k, stateOption := range stateOptions {
+				if !matchedOptions[k] && stateOption.Title.Equal(option.Title) {
+					matchedOptions[k] = true
+					option.ID = stateOption.ID
+					break
+				}
+			}
+		}
+	}
+}
+
+// onboardingChannelSet is a set of channel IDs in the configuration, and the path to report its errors on.
+type onboardingChannelSet struct {
+	path path.Path
+	set  types.Set
+}
+
+// onboardingChannelSets returns the sets of channels that count towards the channel requirements of enabled
+// onboarding. In advanced mode these include the channels of the prompt options.
+func onboardingChannelSets(data DiscordServerOnboardingModel) []onboardingChannelSet {
+	sets := []onboardingChannelSet{{path: path.Root("default_channel_ids"), set: data.DefaultChannelIDs}}
+	if data.Mode.ValueString() == "advanced" {
+		for i, prompt := range data.Prompt {
+			for j, option := range prompt.Option {
+				sets = append(sets, onboardingChannelSet{
+					path: path.Root("prompt").AtListIndex(i).AtName("option").AtListIndex(j).AtName("channel_ids"),
+					set:  option.ChannelIDs,
+				})
+			}
+		}
+	}
+
+	return sets
+}
+
+// onboardingChannelIDs returns the channels that count towards the channel requirements of enabled onboarding, and
+// whether they are all known.
+func onboardingChannelIDs(data DiscordServerOnboardingModel) ([]string, bool) {
+	if data.Mode.IsUnknown() {
+		return nil, false
+	}
+
+	var channelIDs []string
+	seen := map[string]bool{}
+	for _, channels := range onboardingChannelSets(data) {
+		if channels.set.IsUnknown() {
+			return nil, false
+		}
+		for _, element := range channels.set.Elements() {
+			value, ok := element.(types.String)
+			if !ok || value.IsUnknown() {
+				return nil, false
+			}
+			if !seen[value.ValueString()] {
+				seen[value.ValueString()] = true
+				channelIDs = append(channelIDs, value.ValueString())
+			}
+		}
+	}
+
+	return channelIDs, true
+}
+
+func onboardingModeName(mode types.String) string {
+	if mode.IsNull() {
+		return onboardingModes[discordgo.GuildOnboardingModeDefault]
+	}
+
+	return mode.ValueString()
+}
+
+// onboardingIDs hands out the IDs to send for prompts and options. New ones get a placeholder snowflake of the
+// current time, each with its own increment, as Discord needs every prompt and option to have a unique ID.
+type onboardingIDs struct {
+	timestamp int64
+	increment int64
+}
+
+func newOnboardingIDs() *onboardingIDs {
+	return &onboardingIDs{timestamp: time.Now().UnixMilli() - discordEpoch}
+}
+
+// id returns the ID of an existing prompt or option, or a new placeholder snowflake.
+func (g *onboardingIDs) id(id types.String) string {
+	if !id.IsNull() && !id.IsUnknown() {
+		return id.ValueString()
+	}
+	g.increment++
+
+	return strconv.FormatInt(g.timestamp<<22|g.increment&0xFFF, 10)
+}
+
+// everyoneCanSendMessages reports whether @everyone can view and send messages in a channel, from the permissions
+// of the @everyone role and its overwrite in the channel.
+func everyoneCanSendMessages(serverID string, everyone int64, channel *discordgo.Channel) bool {
+	permissions := everyone
+	for _, overwrite := range channel.PermissionOverwrites {
+		if overwrite.ID == serverID {
+			permissions = permissions&^overwrite.Deny | overwrite.Allow
+		}
+	}
+	send := int64(discordgo.PermissionViewChannel | discordgo.PermissionSendMessages)
+
+	return permissions&send == send
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceDiscordServerOnboarding(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testRoleID := os.Getenv("DISCORD_TEST_ROLE_ID")
+	if testServerID == "" || testRoleID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID and DISCORD_TEST_ROLE_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_server_onboarding.example"
+	var promptID, optionID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "discord_server_onboarding" "example" {
+				  server_id = "%s"
+				  default_channel_ids = ["1", "2", "3"]
+				  prompt {
+				    type = "multiple_choice"
+				    title = "What are you here for?"
+				    option {
+				      title = "Nothing"
+				    }
+				  }
+				}`, testServerID),
+				ExpectError: regexp.MustCompile(`(?s)Enabled onboarding needs at least 7 channels in default mode, got 3.*Options must grant at least one role or channel`),
+			},
+			{
+				Config: testAccResourceDiscordServerOnboarding(testServerID, testRoleID, "enabled = false", "discord_text_channel.onboarding[7].id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+					resource.TestCheckResourceAttr(name, "mode", "default"),
+					resource.TestCheckResourceAttr(name, "default_channel_ids.#", "7"),
+					resource.TestCheckResourceAttr(name, "prompt.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "prompt.0.id"),
+					resource.TestCheckResourceAttr(name, "prompt.0.type", "dropdown"),
+					resource.TestCheckResourceAttr(name, "prompt.0.required", "true"),
+					resource.TestCheckResourceAttr(name, "prompt.0.single_select", "false"),
+					resource.TestCheckResourceAttr(name, "prompt.0.in_onboarding", "true"),
+					resource.TestCheckResourceAttr(name, "prompt.0.option.#", "2"),
+					resource.TestCheckResourceAttrSet(name, "prompt.0.option.0.id"),
+					resource.TestCheckResourceAttr(name, "prompt.0.option.0.role_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "prompt.0.option.0.role_ids.*", testRoleID),
+					resource.TestCheckNoResourceAttr(name, "prompt.0.option.0.channel_ids"),
+					resource.TestCheckResourceAttr(name, "prompt.0.option.0.emoji_unicode", "🔔"),
+					resource.TestCheckResourceAttr(name, "prompt.0.option.1.channel_ids.#", "1"),
+					resource.TestCheckNoResourceAttr(name, "prompt.0.option.1.description"),
+				),
+			},
+			{
+				Config:      testAccResourceDiscordServerOnboarding(testServerID, testRoleID, "", "discord_text_channel.onboarding[7].id"),
+				ExpectError: regexp.MustCompile("Enabled onboarding needs @everyone to be able to send messages in at least 5\\s+channels, got 4"),
+			},
+			{
+				Config:      testAccResourceDiscordServerOnboarding(testServerID, testRoleID, `mode = "advanced"`, `"1"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)channel_ids = \["1"\].*Channel 1 is not a channel of server`),
+			},
+			{
+				Config: testAccResourceDiscordServerOnboarding(testServerID, testRoleID, `mode = "advanced"`, "discord_text_channel.onboarding[7].id"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "mode", "advanced"),
+					func(s *terraform.State) error {
+						promptID = s.RootModule().Resources[name].Primary.Attributes["prompt.0.id"]
+						optionID = s.RootModule().Resources[name].Primary.Attributes["prompt.0.option.1.id"]
+						return nil
+					},
+				),
+			},
+			{
+				// The existing prompt keeps its ID when a prompt is inserted before it.
+				Config: testAccResourceDiscordServerOnboarding(testServerID, testRoleID, fmt.Sprintf(`
+				  mode = "advanced"
+				  prompt {
+				    type = "multiple_choice"
+				    title = "Which games do you play?"
+				    option {
+				      title = "Chess"
+				      role_ids = ["%s"]
+				    }
+				  }`, testRoleID), "discord_text_channel.onboarding[7].id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "prompt.#", "2"),
+					resource.TestCheckResourceAttr(name, "prompt.0.title", "Which games do you play?"),
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources[name].Primary.Attributes
+						if attributes["prompt.0.id"] == promptID || attributes["prompt.0.option.0.id"] == optionID {
+							return fmt.Errorf("new prompt got the ID of an existing prompt or option")
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttrPtr(name, "prompt.1.id", &promptID),
+					resource.TestCheckResourceAttrPtr(name, "prompt.1.option.1.id", &optionID),
+				),
+			},
+			{
+				ResourceName:                         name,
+				ImportState:                          true,
+				ImportStateId:                        testServerID,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "server_id",
+				ImportStateVerifyIgnore:              []string{"audit_log_reason"},
+			},
+		},
+	})
+}
+
+func TestAccResourceDiscordServerOnboardingNewPrompts(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testRoleID := os.Getenv("DISCORD_TEST_ROLE_ID")
+	if testServerID == "" || testRoleID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID and DISCORD_TEST_ROLE_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_server_onboarding.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Every new prompt and option is sent with an ID of its own.
+				Config: testAccResourceDiscordServerOnboarding(testServerID, testRoleID, fmt.Sprintf(`
+				  enabled = false
+				  prompt {
+				    type = "multiple_choice"
+				    title = "Which games do you play?"
+				    option {
+				      title = "Chess"
+				      role_ids = ["%[1]s"]
+				    }
+				    option {
+				      title = "Go"
+				      role_ids = ["%[1]s"]
+				    }
+				  }`, testRoleID), "discord_text_channel.onboarding[7].id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "prompt.#", "2"),
+					resource.TestCheckResourceAttr(name, "prompt.0.option.#", "2"),
+					resource.TestCheckResourceAttr(name, "prompt.1.option.#", "2"),
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources[name].Primary.Attributes
+						seen := map[string]bool{}
+						for _, key := range []string{"prompt.0.id", "prompt.0.option.0.id", "prompt.0.option.1.id", "prompt.1.id", "prompt.1.option.0.id", "prompt.1.option.1.id"} {
+							if seen[attributes[key]] {
+								return fmt.Errorf("%s is not unique: %s", key, attributes[key])
+							}
+							seen[attributes[key]] = true
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// testAccResourceDiscordServerOnboarding has seven default channels, three of which @everyone cannot send messages
+// in, and an eighth channel that is only granted by a prompt option with chattingChannel.
+func testAccResourceDiscordServerOnboarding(serverID string, roleID string, onboarding string, chattingChannel string) string {
+	return fmt.Sprintf(`
+	resource "discord_text_channel" "onboarding" {
+	  count = 8
+	  server_id = "%[1]s"
+	  name = "terraform-onboarding-${count.index}"
+	  topic = "Onboarding"
+	}
+
+	resource "discord_channel_permission" "read_only" {
+	  count = 3
+	  channel_id = discord_text_channel.onboarding[count.index].id
+	  type = "role"
+	  overwrite_id = "%[1]s"
+	  deny = 2048
+	}
+
+	resource "discord_server_onboarding" "example" {
+	  server_id = "%[1]s"
+	  %[3]s
+	  default_channel_ids = slice(discord_text_channel.onboarding[*].id, 0, 7)
+	  prompt {
+	    type = "dropdown"
+	    title = "What are you here for?"
+	    required = true
+	    option {
+	      title = "Pings"
+	      description = "Get pinged for announcements"
+	      role_ids = ["%[2]s"]
+	      emoji_unicode = "🔔"
+	    }
+	    option {
+	      title = "Chatting"
+	      channel_ids = [%[4]s]
+	    }
+	  }
+	  audit_log_reason = "Managed by Terraform"
+	  depends_on = [discord_channel_permission.read_only]
+	}`, serverID, roleID, onboarding, chattingChannel)
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordServerTemplateResource{}
+var _ resource.ResourceWithImportState = &DiscordServerTemplateResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordServerTemplateResource{}
+
+func NewDiscordServerTemplateResource() resource.Resource {
+	return &DiscordServerTemplateResource{}
+}
+
+type DiscordServerTemplateResource struct {
+	client *Context
+}
+
+type DiscordServerTemplateModel struct {
+	Code        types.String `tfsdk:"code"`
+	ServerID    types.String `tfsdk:"server_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	URL         types.String `tfsdk:"url"`
+	UsageCount  types.Int64  `tfsdk:"usage_count"`
+	IsDirty     types.Bool   `tfsdk:"is_dirty"`
+}
+
+func (r *DiscordServerTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_template"
+}
+
+func (r *DiscordServerTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Server Template Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"code": schema.StringAttribute{
+				Description: "The code of the template",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": utils.ServerIDAttribute(),
+			"name": schema.StringAttribute{
+				Description: "The name of the template",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the template",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 120),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL to create a server from the template",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"usage_count": schema.Int64Attribute{
+				Description: "How many servers were created from the template",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_dirty": schema.BoolAttribute{
+				Description: "Whether the server changed since the template was synced. Dirty templates are synced on the next apply",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DiscordServerTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan plans a sync of templates that are dirty, so that the template follows the server. Whether the template
+// is dirty after an update is unknown, as the server can change in the same apply.
+func (r *DiscordServerTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state DiscordServerTemplateModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.IsDirty.ValueBool() || !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("is_dirty"), types.BoolUnknown())...)
+	}
+}
+
+func (r *DiscordServerTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordServerTemplateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	template, err := utils.GuildTemplateCreateComplex(r.client.Session, serverID, &utils.GuildTemplateParams{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create template of server %s", serverID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildServerTemplateModel(serverID, template))...)
+}
+
+func (r *DiscordServerTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordServerTemplateModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	templates, err := utils.GetGuildTemplates(r.client.Session, serverID, discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "server", serverID)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get templates of server %s", serverID), err.Error())
+		return
+	}
+	for _, template := range templates {
+		if template.Code == data.Code.ValueString() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, buildServerTemplateModel(serverID, &template))...)
+			return
+		}
+	}
+	utils.RemoveNotFound(ctx, resp, "server template", data.Code.ValueString())
+}
+
+func (r *DiscordServerTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DiscordServerTemplateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	code := data.Code.ValueString()
+	if state.IsDirty.ValueBool() {
+		if _, err := utils.SyncGuildTemplate(r.client.Session, serverID, code, discordgo.WithContext(ctx)); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to sync server template %s", code), err.Error())
+			return
+		}
+	}
+	template, err := utils.GuildTemplateEditComplex(r.client.Session, serverID, code, &utils.GuildTemplateParams{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update server template %s", code), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildServerTemplateModel(serverID, template))...)
+}
+
+func (r *DiscordServerTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordServerTemplateModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.Session.GuildTemplateDelete(data.ServerID.ValueString(), data.Code.ValueString(), discordgo.WithContext(ctx)); err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete server template %s", data.Code.ValueString()), err.Error())
+		return
+	}
+}
+
+func (r *DiscordServerTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverID, code, ok := strings.Cut(req.ID, ":")
+	if !ok || serverID == "" || code == "" {
+		resp.Diagnostics.AddError("error importing Discord Server Template", "invalid ID specified. Please specify the ID as \"server_id:code\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("code"), code)...)
+}
+
+func buildServerTemplateModel(serverID string, template *utils.GuildTemplate) *DiscordServerTemplateModel {
+	model := &DiscordServerTemplateModel{
+		Code:        types.StringValue(template.Code),
+		ServerID:    types.StringValue(serverID),
+		Name:        types.StringValue(template.Name),
+		Description: types.StringNull(),
+		URL:         types.StringValue(utils.GuildTemplateURL + template.Code),
+		UsageCount:  types.Int64Value(int64(template.UsageCount)),
+		IsDirty:     types.BoolValue(template.IsDirty),
+	}
+	if template.Description != nil {
+		model.Description = utils.StringValueOrNull(*template.Description)
+	}
+
+	return model
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceDiscordServerTemplate(t *testing.T) {
+	name := "discord_server_template.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordServerTemplate(`description = "Created by Terraform"`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "server_id", "discord_server.source", "server_id"),
+					resource.TestCheckResourceAttr(name, "name", "terraform-template"),
+					resource.TestCheckResourceAttr(name, "description", "Created by Terraform"),
+					resource.TestCheckResourceAttrSet(name, "code"),
+					resource.TestMatchResourceAttr(name, "url", regexp.MustCompile(`^https://discord\.new/.+`)),
+					resource.TestCheckResourceAttr(name, "is_dirty", "false"),
+					resource.TestCheckResourceAttrPair("discord_server.copy", "template_code", name, "code"),
+				),
+			},
+			{
+				// The new channel makes the template dirty, which plans a sync.
+				Config:             testAccResourceDiscordServerTemplate("", testAccResourceDiscordServerTemplateChannel),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(name, "description"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordServerTemplate("", testAccResourceDiscordServerTemplateChannel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "is_dirty", "false"),
+					resource.TestCheckResourceAttr(name, "usage_count", "1"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccServerTemplateImportID(name),
+				ImportStateVerify: true,
+				// The template is identified by its code, which is not the ID of the resource.
+				ImportStateVerifyIdentifierAttribute: "code",
+			},
+		},
+	})
+}
+
+func testAccServerTemplateImportID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource not found in state: %s", name)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["server_id"], rs.Primary.Attributes["code"]), nil
+	}
+}
+
+const testAccResourceDiscordServerTemplateChannel = `
+	resource "discord_text_channel" "rules" {
+	  server_id = discord_server.source.server_id
+	  name = "rules"
+	  topic = "Server rules"
+	}`
+
+func testAccResourceDiscordServerTemplate(template string, resources string) string {
+	return fmt.Sprintf(`
+	resource "discord_server" "source" {
+	  name = "terraform-template-source"
+	}
+
+	resource "discord_server_template" "example" {
+	  server_id = discord_server.source.server_id
+	  name = "terraform-template"
+	  %[1]s
+	}
+
+	resource "discord_server" "copy" {
+	  name = "terraform-template-copy"
+	  template_code = discord_server_template.example.code
+	}
+	%[2]s`, template, resources)
+}
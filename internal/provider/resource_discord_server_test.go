@@ -1,9 +1,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
 	"testing"
 )
 
@@ -36,6 +39,51 @@ func TestAccResourceDiscordServer(t *testing.T) {
 	})
 }
 
+func TestAccResourceDiscordManagedServer(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "discord_managed_server" "example" {
+				  server_id = "%s"
+				}`, testServerID),
+				ResourceName:  "discord_managed_server.example",
+				ImportState:   true,
+				ImportStateId: testServerID,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported server, got %d", len(states))
+					}
+					if states[0].Attributes["server_id"] != testServerID {
+						return fmt.Errorf("expected server %s, got %s", testServerID, states[0].Attributes["server_id"])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// Changing template_code replaces the server, which would delete a managed server that was imported without one.
+func TestDiscordManagedServerSchema(t *testing.T) {
+	resp := &fwresource.SchemaResponse{}
+	NewDiscordManagedServerResource().Schema(context.Background(), fwresource.SchemaRequest{}, resp)
+	if _, ok := resp.Schema.Attributes["template_code"]; ok {
+		t.Error("expected no template_code on discord_managed_server")
+	}
+	resp = &fwresource.SchemaResponse{}
+	NewDiscordServerResource().Schema(context.Background(), fwresource.SchemaRequest{}, resp)
+	if _, ok := resp.Schema.Attributes["template_code"]; !ok {
+		t.Error("expected template_code on discord_server")
+	}
+}
+
 const testAccResourceDiscordServer = `
 resource "discord_server" "example" {
   name = "example"
@@ -0,0 +1,380 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// maxStickerSize is the largest sticker file Discord accepts.
+	maxStickerSize = 512 * 1024
+	// stickerDimension is the width and height Discord requires of sticker files.
+	stickerDimension = 320
+)
+
+// stickerFormats are the file formats Discord accepts for stickers. APNG files are PNG files.
+var stickerFormats = []string{"image/png", "image/gif", utils.LottieFormat}
+
+// stickerFormatNames are the values of the format_type attribute.
+var stickerFormatNames = map[discordgo.StickerFormat]string{
+	discordgo.StickerFormatTypePNG:    "png",
+	discordgo.StickerFormatTypeAPNG:   "apng",
+	discordgo.StickerFormatTypeLottie: "lottie",
+	discordgo.StickerFormatTypeGIF:    "gif",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordStickerResource{}
+var _ resource.ResourceWithImportState = &DiscordStickerResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordStickerResource{}
+
+func NewDiscordStickerResource() resource.Resource {
+	return &DiscordStickerResource{}
+}
+
+type DiscordStickerResource struct {
+	client *Context
+}
+
+type DiscordStickerModel struct {
+	ID             types.String `tfsdk:"id"`
+	ServerID       types.String `tfsdk:"server_id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Tags           types.Set    `tfsdk:"tags"`
+	File           types.String `tfsdk:"file"`
+	DataURI        types.String `tfsdk:"data_uri"`
+	FileHash       types.String `tfsdk:"file_hash"`
+	FormatType     types.String `tfsdk:"format_type"`
+	AuditLogReason types.String `tfsdk:"audit_log_reason"`
+}
+
+func (r *DiscordStickerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sticker"
+}
+
+func (r *DiscordStickerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Sticker Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the sticker",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": utils.ServerIDAttribute(),
+			"name": schema.StringAttribute{
+				Description: "The name of the sticker",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 30),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the sticker",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 100),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Description: "The names of the emojis related to the sticker, used to suggest it",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"file": schema.StringAttribute{
+				Description: "The path of a 320x320 PNG, APNG or GIF image, or a Lottie JSON animation, of at most 512 KiB",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("file"),
+						path.MatchRoot("data_uri"),
+					),
+				},
+			},
+			"data_uri": schema.StringAttribute{
+				Description: "The file as a data URI, such as the one of the `discord_local_image` data source",
+				Optional:    true,
+			},
+			"file_hash": schema.StringAttribute{
+				Description: "The SHA-256 hash of the file. The sticker is replaced when the file changes, as Discord cannot change the file of a sticker",
+				Computed:    true,
+			},
+			"format_type": schema.StringAttribute{
+				Description: "The format of the sticker. One of `png`, `apng`, `lottie` and `gif`",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
+		},
+	}
+}
+
+func (r *DiscordStickerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan hashes the file so that a changed file replaces the sticker while an unchanged one is not uploaded
+// again.
+func (r *DiscordStickerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan DiscordStickerModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The file is only known at apply time when it comes from another resource.
+	known := !plan.File.IsUnknown() && !plan.DataURI.IsUnknown()
+	utils.ModifyPlanImageHash(ctx, req, resp, path.Root("file_hash"), known, func() ([]byte, diag.Diagnostics) {
+		content, _, diags := loadStickerFile(ctx, plan)
+		return content, diags
+	})
+}
+
+func (r *DiscordStickerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordStickerModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	content, format, diags := loadStickerFile(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	tags, diags := stickerTags(ctx, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	sticker, err := utils.StickerCreateComplex(r.client.Session, serverID, &utils.StickerCreate{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Tags:        tags,
+		File: &discordgo.File{
+			Name:        stickerFileName(data, format),
+			ContentType: format,
+			Reader:      bytes.NewReader(content),
+		},
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create sticker", err.Error())
+		return
+	}
+	data.FileHash = types.StringValue(utils.ImageHash(content))
+
+	model, diags := buildStickerModel(ctx, serverID, sticker, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordStickerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordStickerModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	sticker, err := utils.GuildSticker(r.client.Session, serverID, data.ID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "sticker", data.ID.ValueString())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get sticker %s", data.ID.ValueString()), err.Error())
+		return
+	}
+
+	model, diags := buildStickerModel(ctx, serverID, sticker, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordStickerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DiscordStickerModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tags, diags := stickerTags(ctx, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	// A changed file replaces the sticker, so only the name, description and tags can change here.
+	sticker, err := utils.StickerEditComplex(r.client.Session, serverID, data.ID.ValueString(), &utils.StickerEdit{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Tags:        tags,
+	}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update sticker %s", data.ID.ValueString()), err.Error())
+		return
+	}
+
+	model, diags := buildStickerModel(ctx, serverID, sticker, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordStickerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordStickerModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := utils.GuildStickerDelete(r.client.Session, data.ServerID.ValueString(), data.ID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete sticker %s", data.ID.ValueString()), err.Error())
+		return
+	}
+}
+
+func (r *DiscordStickerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverID, stickerID, ok := strings.Cut(req.ID, ":")
+	if !ok || serverID == "" || stickerID == "" {
+		resp.Diagnostics.AddError("error importing Discord Sticker", "invalid ID specified. Please specify the ID as \"server_id:sticker_id\"")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), stickerID)...)
+}
+
+// loadStickerFile reads the configured file and checks it against the Discord sticker limits, so that a bad file
+// fails the plan instead of the API call.
+func loadStickerFile(ctx context.Context, data DiscordStickerModel) ([]byte, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	content, err := utils.LoadImage(ctx, nil, utils.ImageSource{
+		File:    data.File.ValueString(),
+		DataURI: data.DataURI.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Failed to read sticker file", err.Error())
+		return nil, "", diags
+	}
+	format, err := utils.ValidateImage(content, maxStickerSize, stickerFormats...)
+	if err != nil {
+		diags.AddError("Invalid sticker file", err.Error())
+		return nil, "", diags
+	}
+	width, height, err := utils.ImageDimensions(content)
+	if err != nil {
+		diags.AddError("Invalid sticker file", err.Error())
+		return nil, "", diags
+	}
+	if width != stickerDimension || height != stickerDimension {
+		diags.AddError("Invalid sticker file", fmt.Sprintf("the sticker is %dx%d, it must be %dx%d", width, height, stickerDimension, stickerDimension))
+		return nil, "", diags
+	}
+
+	return content, format, diags
+}
+
+// stickerFileName is the name the file is uploaded with. Discord only looks at its extension.
+func stickerFileName(data DiscordStickerModel, format string) string {
+	if file := data.File.ValueString(); file != "" {
+		return filepath.Base(file)
+	}
+	switch format {
+	case "image/gif":
+		return "sticker.gif"
+	case utils.LottieFormat:
+		return "sticker.json"
+	}
+
+	return "sticker.png"
+}
+
+// stickerTags joins the tags the way Discord stores them.
+func stickerTags(ctx context.Context, set types.Set) (string, diag.Diagnostics) {
+	var tags []string
+	diags := set.ElementsAs(ctx, &tags, false)
+	sort.Strings(tags)
+
+	return strings.Join(tags, ","), diags
+}
+
+func buildStickerModel(ctx context.Context, serverID string, sticker *discordgo.Sticker, data DiscordStickerModel) (*DiscordStickerModel, diag.Diagnostics) {
+	tags := []string{}
+	for _, tag := range strings.Split(sticker.Tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	tagSet, diags := types.SetValueFrom(ctx, types.StringType, tags)
+
+	return &DiscordStickerModel{
+		ID:             types.StringValue(sticker.ID),
+		ServerID:       types.StringValue(serverID),
+		Name:           types.StringValue(sticker.Name),
+		Description:    utils.StringValueOrNull(sticker.Description),
+		Tags:           tagSet,
+		File:           data.File,
+		DataURI:        data.DataURI,
+		FileHash:       data.FileHash,
+		FormatType:     types.StringValue(stickerFormatNames[sticker.FormatType]),
+		AuditLogReason: data.AuditLogReason,
+	}, diags
+}
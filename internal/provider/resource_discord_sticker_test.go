@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"image/color"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceDiscordSticker(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	dir := t.TempDir()
+	file := filepath.Join(dir, "sticker.png")
+	sticker := testAccPNG(t, 320, color.RGBA{R: 0xff, A: 0xff})
+	if err := os.WriteFile(file, sticker, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	small := filepath.Join(dir, "small.png")
+	if err := os.WriteFile(small, testAccPNG(t, 32, color.RGBA{R: 0xff, A: 0xff}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	lottie := []byte(`{"v":"5.5.2","fr":30,"ip":0,"op":60,"w":320,"h":320,"layers":[]}`)
+	name := "discord_sticker.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceDiscordSticker(testServerID, "terraform-small", fmt.Sprintf("file = %q", small), `["wave"]`),
+				ExpectError: regexp.MustCompile("the sticker is 32x32, it must be 320x320"),
+			},
+			{
+				Config: testAccResourceDiscordSticker(testServerID, "terraform-sticker", fmt.Sprintf("file = %q\n  description = \"Waving Terraform\"", file), `["wave", "smile"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "terraform-sticker"),
+					resource.TestCheckResourceAttr(name, "description", "Waving Terraform"),
+					resource.TestCheckResourceAttr(name, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(name, "tags.*", "smile"),
+					resource.TestCheckResourceAttr(name, "format_type", "png"),
+					resource.TestCheckResourceAttr(name, "file_hash", utils.ImageHash(sticker)),
+				),
+			},
+			{
+				Config: testAccResourceDiscordSticker(testServerID, "terraform-renamed", fmt.Sprintf("file = %q", file), `["wave"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "terraform-renamed"),
+					resource.TestCheckNoResourceAttr(name, "description"),
+					resource.TestCheckResourceAttr(name, "tags.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordSticker(testServerID, "terraform-renamed", fmt.Sprintf("data_uri = %q", utils.ImageDataURI(utils.LottieFormat, lottie)), `["wave"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "format_type", "lottie"),
+					resource.TestCheckResourceAttr(name, "file_hash", utils.ImageHash(lottie)),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s:", testServerID),
+				ImportStateVerify:   true,
+				// The file and its hash only exist in the configuration.
+				ImportStateVerifyIgnore: []string{"audit_log_reason", "data_uri", "file_hash"},
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordSticker(serverID string, name string, file string, tags string) string {
+	return fmt.Sprintf(`
+	resource "discord_sticker" "example" {
+	  server_id = "%[1]s"
+	  name = "%[2]s"
+	  %[3]s
+	  tags = %[4]s
+	  audit_log_reason = "Managed by Terraform"
+	}`, serverID, name, file, tags)
+}
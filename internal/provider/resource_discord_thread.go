@@ -0,0 +1,413 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordThreadResource{}
+var _ resource.ResourceWithImportState = &DiscordThreadResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordThreadResource{}
+var _ resource.ResourceWithValidateConfig = &DiscordThreadResource{}
+
+func NewDiscordThreadResource() resource.Resource {
+	return &DiscordThreadResource{}
+}
+
+type DiscordThreadResource struct {
+	client *Context
+}
+
+type DiscordThreadModel struct {
+	ID                  types.String `tfsdk:"id"`
+	ServerID            types.String `tfsdk:"server_id"`
+	ChannelID           types.String `tfsdk:"channel_id"`
+	MessageID           types.String `tfsdk:"message_id"`
+	Name                types.String `tfsdk:"name"`
+	Type                types.String `tfsdk:"type"`
+	AutoArchiveDuration types.Int64  `tfsdk:"auto_archive_duration"`
+	Archived            types.Bool   `tfsdk:"archived"`
+	Locked              types.Bool   `tfsdk:"locked"`
+	Invitable           types.Bool   `tfsdk:"invitable"`
+	Slowmode            types.Int64  `tfsdk:"slowmode"`
+
+	AuditLogReason types.String `tfsdk:"audit_log_reason"`
+}
+
+func (r *DiscordThreadResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_thread"
+}
+
+func (r *DiscordThreadResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Thread Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The thread ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Description: "The server ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the text or news channel the thread is in",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message_id": schema.StringAttribute{
+				Description: "The ID of the message to start the thread from. The thread is started without a message when not set",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The thread name",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The thread type. One of `public`, `private` or `announcement`. Threads started from a message are `public`, or `announcement` in news channels. Defaults to `public`",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("public", "private", "announcement"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_archive_duration": schema.Int64Attribute{
+				Description: "The duration in minutes after which the thread is archived when there is no activity. One of `60`, `1440`, `4320` or `10080`",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(60, 1440, 4320, 10080),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"archived": schema.BoolAttribute{
+				Description: "Whether the thread is archived",
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+				Computed:    true,
+			},
+			"locked": schema.BoolAttribute{
+				Description: "Whether the thread is locked. Only members with the Manage Threads permission can unarchive a locked thread",
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+				Computed:    true,
+			},
+			"invitable": schema.BoolAttribute{
+				Description: "Whether members who are not moderators can add other members to the thread. Only applies to private threads",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"slowmode": schema.Int64Attribute{
+				Description: "The slowmode in seconds members have to wait between sending messages",
+				Optional:    true,
+				Default:     int64default.StaticInt64(0),
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 21600),
+				},
+			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
+		},
+	}
+}
+
+func (r *DiscordThreadResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that the thread type fits how the thread is started, so that a thread Discord would reject
+// fails the plan.
+func (r *DiscordThreadResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DiscordThreadModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() {
+		return
+	}
+	fromMessage := !data.MessageID.IsNull()
+	if fromMessage && data.Type.ValueString() == "private" {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid thread type", "Threads started from a message cannot be private.")
+	}
+	if !data.Invitable.IsNull() && (fromMessage || data.Type.ValueString() != "private") {
+		resp.Diagnostics.AddAttributeError(path.Root("invitable"), "Invalid thread setting", "`invitable` can only be set on private threads.")
+	}
+}
+
+// ModifyPlan plans the type of threads started from a message, which Discord decides from the type of the channel.
+// The channel is checked again at apply time when it is not known yet.
+func (r *DiscordThreadResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config DiscordThreadModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || plan.MessageID.IsNull() || plan.ChannelID.IsUnknown() || config.Type.IsUnknown() {
+		return
+	}
+
+	channelID := plan.ChannelID.ValueString()
+	channel, err := r.client.Session.Channel(channelID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get channel %s", channelID), err.Error())
+		return
+	}
+	threadType := "public"
+	if channel.Type == discordgo.ChannelTypeGuildNews {
+		threadType = "announcement"
+	}
+	if config.Type.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"), threadType)...)
+		return
+	}
+	if config.Type.ValueString() != threadType {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid thread type",
+			fmt.Sprintf("Threads started from a message in channel %s are %s threads.", channelID, threadType),
+		)
+	}
+}
+
+func (r *DiscordThreadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordThreadModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+
+	threadStart := &discordgo.ThreadStart{
+		Name:                data.Name.ValueString(),
+		AutoArchiveDuration: int(data.AutoArchiveDuration.ValueInt64()),
+		Invitable:           data.Invitable.IsUnknown() || data.Invitable.ValueBool(),
+		RateLimitPerUser:    int(data.Slowmode.ValueInt64()),
+	}
+	var thread *discordgo.Channel
+	var err error
+	if data.MessageID.ValueString() != "" {
+		thread, err = client.MessageThreadStartComplex(data.ChannelID.ValueString(), data.MessageID.ValueString(), threadStart, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	} else {
+		threadType := "public"
+		if !data.Type.IsUnknown() {
+			threadType = data.Type.ValueString()
+		}
+		threadStart.Type, _ = utils.GetThreadType(threadType)
+		thread, err = client.ThreadStartComplex(data.ChannelID.ValueString(), threadStart, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create thread", err.Error())
+		return
+	}
+
+	// Threads cannot be started archived or locked.
+	if data.Archived.ValueBool() || data.Locked.ValueBool() {
+		threadID := thread.ID
+		thread, err = client.ChannelEditComplex(threadID, &discordgo.ChannelEdit{
+			Archived: data.Archived.ValueBoolPointer(),
+			Locked:   data.Locked.ValueBoolPointer(),
+		}, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to archive thread %s", threadID), err.Error())
+			return
+		}
+	}
+
+	model, err := buildThreadModel(thread, data.MessageID, data.AuditLogReason)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build thread model", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordThreadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordThreadModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	thread, err := client.Channel(data.ID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "thread", data.ID.ValueString())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get thread %s", data.ID.ValueString()), err.Error())
+		return
+	}
+
+	model, err := buildThreadModel(thread, data.MessageID, data.AuditLogReason)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build thread model", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordThreadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DiscordThreadModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	threadID := state.ID.ValueString()
+
+	// Archived threads can only be unarchived, so unarchive the thread before editing anything else. It is archived
+	// again below when the plan says so.
+	if state.Archived.ValueBool() {
+		archived := false
+		if _, err := client.ChannelEditComplex(threadID, &discordgo.ChannelEdit{Archived: &archived}, discordgo.WithContext(ctx), utils.WithAuditLogReason(plan.AuditLogReason)); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to unarchive thread %s", threadID), err.Error())
+			return
+		}
+	}
+
+	slowmode := int(plan.Slowmode.ValueInt64())
+	threadEdit := &discordgo.ChannelEdit{
+		Name:                plan.Name.ValueString(),
+		AutoArchiveDuration: int(plan.AutoArchiveDuration.ValueInt64()),
+		RateLimitPerUser:    &slowmode,
+		Locked:              plan.Locked.ValueBoolPointer(),
+		Archived:            plan.Archived.ValueBoolPointer(),
+	}
+	if state.Type.ValueString() == "private" && !plan.Invitable.IsUnknown() {
+		threadEdit.Invitable = plan.Invitable.ValueBoolPointer()
+	}
+	thread, err := client.ChannelEditComplex(threadID, threadEdit, discordgo.WithContext(ctx), utils.WithAuditLogReason(plan.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update thread %s", threadID), err.Error())
+		return
+	}
+
+	model, err := buildThreadModel(thread, plan.MessageID, plan.AuditLogReason)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build thread model", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *DiscordThreadResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordThreadModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.client.Session
+	if _, err := client.ChannelDelete(data.ID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete thread %s", data.ID.ValueString()), err.Error())
+		return
+	}
+}
+
+func (r *DiscordThreadResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.Session
+	thread, err := client.Channel(req.ID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get thread %s", req.ID), err.Error())
+		return
+	}
+	// Threads started from a message share the ID of the message.
+	if _, err := client.ChannelMessage(thread.ParentID, thread.ID, discordgo.WithContext(ctx)); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("message_id"), thread.ID)...)
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func buildThreadModel(thread *discordgo.Channel, messageID types.String, auditLogReason types.String) (*DiscordThreadModel, error) {
+	threadType, okay := utils.GetThreadTypeString(thread.Type)
+	if !okay || thread.ThreadMetadata == nil {
+		return nil, fmt.Errorf("channel %s is not a thread", thread.ID)
+	}
+	invitable := types.BoolNull()
+	if thread.Type == discordgo.ChannelTypeGuildPrivateThread {
+		invitable = types.BoolValue(thread.ThreadMetadata.Invitable)
+	}
+
+	return &DiscordThreadModel{
+		ID:                  types.StringValue(thread.ID),
+		ServerID:            types.StringValue(thread.GuildID),
+		ChannelID:           types.StringValue(thread.ParentID),
+		MessageID:           messageID,
+		Name:                types.StringValue(thread.Name),
+		Type:                types.StringValue(threadType),
+		AutoArchiveDuration: types.Int64Value(int64(thread.ThreadMetadata.AutoArchiveDuration)),
+		Archived:            types.BoolValue(thread.ThreadMetadata.Archived),
+		Locked:              types.BoolValue(thread.ThreadMetadata.Locked),
+		Invitable:           invitable,
+		Slowmode:            types.Int64Value(int64(thread.RateLimitPerUser)),
+		AuditLogReason:      auditLogReason,
+	}, nil
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceDiscordThread(t *testing.T) {
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	if testChannelID == "" {
+		t.Skip("DISCORD_TEST_CHANNEL_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_thread.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordThread(testChannelID, "terraform-faq", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "channel_id", testChannelID),
+					resource.TestCheckResourceAttr(name, "name", "terraform-faq"),
+					resource.TestCheckResourceAttr(name, "type", "private"),
+					resource.TestCheckResourceAttr(name, "auto_archive_duration", "10080"),
+					resource.TestCheckResourceAttr(name, "invitable", "false"),
+					resource.TestCheckResourceAttr(name, "slowmode", "10"),
+					resource.TestCheckResourceAttr(name, "archived", "true"),
+					resource.TestCheckResourceAttr(name, "locked", "false"),
+					resource.TestCheckResourceAttrSet(name, "server_id"),
+					resource.TestCheckNoResourceAttr(name, "message_id"),
+				),
+			},
+			{
+				// Renaming an archived thread unarchives it first.
+				Config: testAccResourceDiscordThread(testChannelID, "terraform-faq-renamed", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "terraform-faq-renamed"),
+					resource.TestCheckResourceAttr(name, "archived", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceDiscordThreadFromMessage(t *testing.T) {
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	if testChannelID == "" {
+		t.Skip("DISCORD_TEST_CHANNEL_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_thread.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "discord_message" "example" {
+				  channel_id = "%[1]s"
+				  content = "Incident thread"
+				}
+
+				resource "discord_thread" "example" {
+				  channel_id = "%[1]s"
+				  message_id = discord_message.example.id
+				  name = "terraform-incident"
+				  type = "private"
+				  invitable = true
+				}`, testChannelID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Threads started from a message cannot be private.*can only be set on private threads`),
+			},
+			{
+				Config: testAccResourceDiscordThreadFromMessage(testChannelID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "message_id", "discord_message.example", "id"),
+					resource.TestCheckResourceAttrPair(name, "id", "discord_message.example", "id"),
+					resource.TestCheckResourceAttr(name, "type", "public"),
+					resource.TestCheckResourceAttr(name, "locked", "true"),
+					resource.TestCheckNoResourceAttr(name, "invitable"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceDiscordThreadFromAnnouncement(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_thread.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The news channel is only known at apply time, where the type is checked before the thread is started.
+				Config:      testAccResourceDiscordThreadFromAnnouncement(testServerID, `type = "public"`),
+				ExpectError: regexp.MustCompile(`Threads started from a message in channel \d+ are\s+announcement threads`),
+			},
+			{
+				Config: testAccResourceDiscordThreadFromAnnouncement(testServerID, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "announcement"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordThread(channelID string, name string, archived bool) string {
+	return fmt.Sprintf(`
+	resource "discord_thread" "example" {
+	  channel_id = "%[1]s"
+	  name = "%[2]s"
+	  type = "private"
+	  auto_archive_duration = 10080
+	  invitable = false
+	  slowmode = 10
+	  archived = %[3]t
+	}`, channelID, name, archived)
+}
+
+func testAccResourceDiscordThreadFromMessage(channelID string) string {
+	return fmt.Sprintf(`
+	resource "discord_message" "example" {
+	  channel_id = "%[1]s"
+	  content = "Incident thread"
+	}
+
+	resource "discord_thread" "example" {
+	  channel_id = "%[1]s"
+	  message_id = discord_message.example.id
+	  name = "terraform-incident"
+	  locked = true
+	}`, channelID)
+}
+
+// testAccResourceDiscordThreadFromAnnouncement starts a thread from a message in a news channel.
+func testAccResourceDiscordThreadFromAnnouncement(serverID string, thread string) string {
+	return fmt.Sprintf(`
+	resource "discord_news_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-announcements"
+	  topic = "Release notes"
+	}
+
+	resource "discord_message" "example" {
+	  channel_id = discord_news_channel.example.id
+	  content = "Release notes"
+	}
+
+	resource "discord_thread" "example" {
+	  channel_id = discord_news_channel.example.id
+	  message_id = discord_message.example.id
+	  name = "terraform-release"
+	  %[2]s
+	}`, serverID, thread)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
 	"github.com/bwmarrin/discordgo"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,17 +29,18 @@ type DiscordWebhook struct {
 }
 
 type DiscordWebhookModel struct {
-	ID            types.String `tfsdk:"id"`
-	ChannelID     types.String `tfsdk:"channel_id"`
-	GuildID       types.String `tfsdk:"guild_id"`
-	Name          types.String `tfsdk:"name"`
-	AvatarURL     types.String `tfsdk:"avatar_url"`
-	AvatarDataURI types.String `tfsdk:"avatar_data_uri"`
-	AvatarHash    types.String `tfsdk:"avatar_hash"`
-	Token         types.String `tfsdk:"token"`
-	URL           types.String `tfsdk:"url"`
-	SlackURL      types.String `tfsdk:"slack_url"`
-	GithubURL     types.String `tfsdk:"github_url"`
+	ID             types.String `tfsdk:"id"`
+	ChannelID      types.String `tfsdk:"channel_id"`
+	GuildID        types.String `tfsdk:"guild_id"`
+	Name           types.String `tfsdk:"name"`
+	AvatarURL      types.String `tfsdk:"avatar_url"`
+	AvatarDataURI  types.String `tfsdk:"avatar_data_uri"`
+	AvatarHash     types.String `tfsdk:"avatar_hash"`
+	Token          types.String `tfsdk:"token"`
+	URL            types.String `tfsdk:"url"`
+	SlackURL       types.String `tfsdk:"slack_url"`
+	GithubURL      types.String `tfsdk:"github_url"`
+	AuditLogReason types.String `tfsdk:"audit_log_reason"`
 }
 
 func (r *DiscordWebhook) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -127,6 +129,7 @@ func (r *DiscordWebhook) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:    true,
 				Sensitive:   true,
 			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
 		},
 	}
 }
@@ -149,24 +152,25 @@ func (r *DiscordWebhook) Create(ctx context.Context, req resource.CreateRequest,
 	} else if data.AvatarDataURI.ValueString() != "" {
 		avatar = data.AvatarDataURI.ValueString()
 	}
-	webhook, err := client.WebhookCreate(channelId, data.Name.ValueString(), avatar, discordgo.WithContext(ctx))
+	webhook, err := client.WebhookCreate(channelId, data.Name.ValueString(), avatar, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create a webhook", err.Error())
 		return
 	}
 	webhookURL := fmt.Sprintf("https://discord.com/api/webhooks/%s/%s", webhook.ID, webhook.Token)
 	data = &DiscordWebhookModel{
-		ID:            types.StringValue(webhook.ID),
-		ChannelID:     types.StringValue(channelId),
-		Name:          types.StringValue(data.Name.ValueString()),
-		GuildID:       types.StringValue(webhook.GuildID),
-		AvatarURL:     types.StringValue(data.AvatarURL.ValueString()),
-		AvatarDataURI: types.StringValue(data.AvatarDataURI.ValueString()),
-		AvatarHash:    types.StringValue(webhook.Avatar),
-		Token:         types.StringValue(webhook.Token),
-		URL:           types.StringValue(webhookURL),
-		SlackURL:      types.StringValue(webhookURL + "/slack"),
-		GithubURL:     types.StringValue(webhookURL + "/github"),
+		ID:             types.StringValue(webhook.ID),
+		ChannelID:      types.StringValue(channelId),
+		Name:           types.StringValue(data.Name.ValueString()),
+		GuildID:        types.StringValue(webhook.GuildID),
+		AvatarURL:      types.StringValue(data.AvatarURL.ValueString()),
+		AvatarDataURI:  types.StringValue(data.AvatarDataURI.ValueString()),
+		AvatarHash:     types.StringValue(webhook.Avatar),
+		Token:          types.StringValue(webhook.Token),
+		URL:            types.StringValue(webhookURL),
+		SlackURL:       types.StringValue(webhookURL + "/slack"),
+		GithubURL:      types.StringValue(webhookURL + "/github"),
+		AuditLogReason: data.AuditLogReason,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -183,23 +187,28 @@ func (r *DiscordWebhook) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 	client := r.client.Session
 	webhook, err := client.Webhook(data.ID.ValueString(), discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "webhook", data.ID.ValueString())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get webhook %s", data.ID.ValueString()), err.Error())
 		return
 	}
 	webhookURL := fmt.Sprintf("https://discord.com/api/webhooks/%s/%s", webhook.ID, webhook.Token)
 	data = &DiscordWebhookModel{
-		ID:            types.StringValue(webhook.ID),
-		ChannelID:     types.StringValue(webhook.ChannelID),
-		Name:          types.StringValue(webhook.Name),
-		GuildID:       types.StringValue(webhook.GuildID),
-		AvatarURL:     types.StringValue(data.AvatarURL.ValueString()),
-		AvatarDataURI: types.StringValue(data.AvatarDataURI.ValueString()),
-		AvatarHash:    types.StringValue(webhook.Avatar),
-		Token:         types.StringValue(webhook.Token),
-		URL:           types.StringValue(webhookURL),
-		SlackURL:      types.StringValue(webhookURL + "/slack"),
-		GithubURL:     types.StringValue(webhookURL + "/github"),
+		ID:             types.StringValue(webhook.ID),
+		ChannelID:      types.StringValue(webhook.ChannelID),
+		Name:           types.StringValue(webhook.Name),
+		GuildID:        types.StringValue(webhook.GuildID),
+		AvatarURL:      types.StringValue(data.AvatarURL.ValueString()),
+		AvatarDataURI:  types.StringValue(data.AvatarDataURI.ValueString()),
+		AvatarHash:     types.StringValue(webhook.Avatar),
+		Token:          types.StringValue(webhook.Token),
+		URL:            types.StringValue(webhookURL),
+		SlackURL:       types.StringValue(webhookURL + "/slack"),
+		GithubURL:      types.StringValue(webhookURL + "/github"),
+		AuditLogReason: data.AuditLogReason,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -222,7 +231,7 @@ func (r *DiscordWebhook) Update(ctx context.Context, req resource.UpdateRequest,
 	} else if data.AvatarDataURI.ValueString() != "" {
 		avatar = data.AvatarDataURI.ValueString()
 	}
-	webhook, err := client.WebhookEdit(data.ID.ValueString(), data.Name.ValueString(), avatar, channelId, discordgo.WithContext(ctx))
+	webhook, err := client.WebhookEdit(data.ID.ValueString(), data.Name.ValueString(), avatar, channelId, discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update webhook %s", data.ID.ValueString()), err.Error())
 		return
@@ -230,20 +239,21 @@ func (r *DiscordWebhook) Update(ctx context.Context, req resource.UpdateRequest,
 
 	webhookURL := fmt.Sprintf("https://discord.com/api/webhooks/%s/%s", webhook.ID, webhook.Token)
 	data = &DiscordWebhookModel{
-		ID:            types.StringValue(webhook.ID),
-		ChannelID:     types.StringValue(webhook.ChannelID),
-		GuildID:       types.StringValue(webhook.GuildID),
-		Name:          types.StringValue(data.Name.ValueString()),
-		AvatarURL:     types.StringValue(data.AvatarURL.ValueString()),
-		AvatarDataURI: types.StringValue(data.AvatarDataURI.ValueString()),
-		AvatarHash:    types.StringValue(webhook.Avatar),
-		Token:         types.StringValue(webhook.Token),
-		URL:           types.StringValue(webhookURL),
-		SlackURL:      types.StringValue(webhookURL + "/slack"),
-		GithubURL:     types.StringValue(webhookURL + "/github"),
+		ID:             types.StringValue(webhook.ID),
+		ChannelID:      types.StringValue(webhook.ChannelID),
+		GuildID:        types.StringValue(webhook.GuildID),
+		Name:           types.StringValue(data.Name.ValueString()),
+		AvatarURL:      types.StringValue(data.AvatarURL.ValueString()),
+		AvatarDataURI:  types.StringValue(data.AvatarDataURI.ValueString()),
+		AvatarHash:     types.StringValue(webhook.Avatar),
+		Token:          types.StringValue(webhook.Token),
+		URL:            types.StringValue(webhookURL),
+		SlackURL:       types.StringValue(webhookURL + "/slack"),
+		GithubURL:      types.StringValue(webhookURL + "/github"),
+		AuditLogReason: data.AuditLogReason,
 	}
 
-	resp.Diagnostics.AddError("failed to discord invite", "Not implemented")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DiscordWebhook) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -256,7 +266,7 @@ func (r *DiscordWebhook) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 	client := r.client.Session
-	if err := client.WebhookDelete(data.ID.ValueString(), discordgo.WithContext(ctx)); err != nil {
+	if err := client.WebhookDelete(data.ID.ValueString(), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete webhook %s", data.ID.ValueString()), err.Error())
 		return
 	}
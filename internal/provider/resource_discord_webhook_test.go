@@ -12,17 +12,21 @@ func TestAccResourceDiscordWebhook(t *testing.T) {
 	if testChannelID == "" {
 		t.Skip("DISCORD_TEST_CHANNEL_ID envvar must be set for acceptance tests")
 	}
+	testAvatarURL := os.Getenv("DISCORD_TEST_AVATAR_URL")
+	if testAvatarURL == "" {
+		testAvatarURL = "https://public-files.cyberjake.xyz/terraform.png"
+	}
 	name := "discord_webhook.example"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccResourceDiscordWebhook(testChannelID),
+				Config: testAccResourceDiscordWebhook(testChannelID, testAvatarURL),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(name, "channel_id", testChannelID),
 					resource.TestCheckResourceAttr(name, "name", "terraform-test"),
-					resource.TestCheckResourceAttr(name, "avatar_url", "https://public-files.cyberjake.xyz/terraform.png"),
+					resource.TestCheckResourceAttr(name, "avatar_url", testAvatarURL),
 					resource.TestCheckResourceAttrSet(name, "token"),
 					resource.TestCheckResourceAttrSet(name, "url"),
 					resource.TestCheckResourceAttrSet(name, "slack_url"),
@@ -37,11 +41,11 @@ func TestAccResourceDiscordWebhook(t *testing.T) {
 	})
 }
 
-func testAccResourceDiscordWebhook(channelID string) string {
+func testAccResourceDiscordWebhook(channelID string, avatarURL string) string {
 	return fmt.Sprintf(`
 	resource "discord_webhook" "example" {
       channel_id = "%[1]s"
       name = "terraform-test"
-	  avatar_url = "https://public-files.cyberjake.xyz/terraform.png"
-	}`, channelID)
+	  avatar_url = "%[2]s"
+	}`, channelID, avatarURL)
 }
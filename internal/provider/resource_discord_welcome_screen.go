@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/Cyb3r-Jak3/discord-terraform/internal/utils"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DiscordWelcomeScreenResource{}
+var _ resource.ResourceWithImportState = &DiscordWelcomeScreenResource{}
+var _ resource.ResourceWithModifyPlan = &DiscordWelcomeScreenResource{}
+
+func NewDiscordWelcomeScreenResource() resource.Resource {
+	return &DiscordWelcomeScreenResource{}
+}
+
+type DiscordWelcomeScreenResource struct {
+	client *Context
+}
+
+type DiscordWelcomeScreenModel struct {
+	ServerID       types.String                       `tfsdk:"server_id"`
+	Enabled        types.Bool                         `tfsdk:"enabled"`
+	Description    types.String                       `tfsdk:"description"`
+	WelcomeChannel []DiscordWelcomeScreenChannelModel `tfsdk:"welcome_channel"`
+	AuditLogReason types.String                       `tfsdk:"audit_log_reason"`
+}
+
+type DiscordWelcomeScreenChannelModel struct {
+	ChannelID    types.String `tfsdk:"channel_id"`
+	Description  types.String `tfsdk:"description"`
+	EmojiID      types.String `tfsdk:"emoji_id"`
+	EmojiUnicode types.String `tfsdk:"emoji_unicode"`
+}
+
+func (r *DiscordWelcomeScreenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_welcome_screen"
+}
+
+func (r *DiscordWelcomeScreenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Discord Welcome Screen Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"server_id": utils.ServerIDAttribute(),
+			"enabled": schema.BoolAttribute{
+				Description: "Whether new members see the welcome screen",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the server shown on the welcome screen",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 140),
+				},
+			},
+			"audit_log_reason": utils.AuditLogReasonAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"welcome_channel": schema.ListNestedBlock{
+				Description: "A channel shown on the welcome screen, at most 5",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"channel_id": schema.StringAttribute{
+							Description: "The ID of the channel. It must be a channel of the server",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "What the channel is for",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 42),
+							},
+						},
+						"emoji_id": schema.StringAttribute{
+							Description: "The ID of a custom emoji shown next to the channel",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("emoji_unicode")),
+							},
+						},
+						"emoji_unicode": schema.StringAttribute{
+							Description: "A unicode emoji shown next to the channel",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DiscordWelcomeScreenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Context)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *Context, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks that the welcome channels are channels of the server, as Discord only answers with an invalid
+// form body otherwise.
+func (r *DiscordWelcomeScreenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	utils.ModifyPlanServerID(ctx, r.client.Config.DefaultServerID, req, resp)
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan DiscordWelcomeScreenModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ServerID.IsUnknown() || len(plan.WelcomeChannel) == 0 {
+		return
+	}
+	serverID := plan.ServerID.ValueString()
+	channels, err := r.client.Session.GuildChannels(serverID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get channels of server %s", serverID), err.Error())
+		return
+	}
+	serverChannels := make(map[string]bool, len(channels))
+	for _, channel := range channels {
+		serverChannels[channel.ID] = true
+	}
+	for i, welcome := range plan.WelcomeChannel {
+		// Channels created in the same apply are checked by Discord instead.
+		if welcome.ChannelID.IsUnknown() || serverChannels[welcome.ChannelID.ValueString()] {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("welcome_channel").AtListIndex(i).AtName("channel_id"),
+			"Invalid welcome channel",
+			fmt.Sprintf("Channel %s is not a channel of server %s.", welcome.ChannelID.ValueString(), serverID),
+		)
+	}
+}
+
+func (r *DiscordWelcomeScreenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscordWelcomeScreenModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	screen, err := utils.WelcomeScreenEditComplex(r.client.Session, data.ServerID.ValueString(), buildWelcomeScreenEdit(data, data.Enabled.ValueBool()), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update welcome screen of server %s", data.ServerID.ValueString()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildWelcomeScreenModel(screen, data.Enabled.ValueBool(), data))...)
+}
+
+func (r *DiscordWelcomeScreenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscordWelcomeScreenModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	serverID := data.ServerID.ValueString()
+	server, err := r.client.Session.Guild(serverID, discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "server", serverID)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error fetching server %s", serverID), err.Error())
+		return
+	}
+	screen, err := utils.GetWelcomeScreen(r.client.Session, serverID, discordgo.WithContext(ctx))
+	if utils.IsNotFound(err) {
+		utils.RemoveNotFound(ctx, resp, "welcome screen", serverID)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get welcome screen of server %s", serverID), err.Error())
+		return
+	}
+	enabled := false
+	for _, feature := range server.Features {
+		enabled = enabled || feature == discordgo.GuildFeatureWelcomeScreenEnabled
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildWelcomeScreenModel(screen, enabled, data))...)
+}
+
+func (r *DiscordWelcomeScreenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DiscordWelcomeScreenModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	screen, err := utils.WelcomeScreenEditComplex(r.client.Session, data.ServerID.ValueString(), buildWelcomeScreenEdit(data, data.Enabled.ValueBool()), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update welcome screen of server %s", data.ServerID.ValueString()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildWelcomeScreenModel(screen, data.Enabled.ValueBool(), data))...)
+}
+
+// Delete disables the welcome screen. A server always has one, so its channels and description are kept for when
+// it is enabled again.
+func (r *DiscordWelcomeScreenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscordWelcomeScreenModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, err := utils.WelcomeScreenEditComplex(r.client.Session, data.ServerID.ValueString(), buildWelcomeScreenEdit(data, false), discordgo.WithContext(ctx), utils.WithAuditLogReason(data.AuditLogReason)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to disable welcome screen of server %s", data.ServerID.ValueString()), err.Error())
+		return
+	}
+}
+
+func (r *DiscordWelcomeScreenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("server_id"), req, resp)
+}
+
+func buildWelcomeScreenEdit(data DiscordWelcomeScreenModel, enabled bool) *utils.WelcomeScreenEdit {
+	edit := &utils.WelcomeScreenEdit{
+		Enabled:     enabled,
+		Description: data.Description.ValueStringPointer(),
+	}
+	for _, welcome := range data.WelcomeChannel {
+		edit.WelcomeChannels = append(edit.WelcomeChannels, utils.WelcomeScreenChannel{
+			ChannelID:   welcome.ChannelID.ValueString(),
+			Description: welcome.Description.ValueString(),
+			EmojiID:     welcome.EmojiID.ValueStringPointer(),
+			EmojiName:   welcome.EmojiUnicode.ValueStringPointer(),
+		})
+	}
+
+	return edit
+}
+
+// buildWelcomeScreenModel returns the welcome screen as the resource sees it. Discord also returns the name of
+// custom emojis, which is only kept for unicode ones.
+func buildWelcomeScreenModel(screen *utils.WelcomeScreen, enabled bool, data DiscordWelcomeScreenModel) *DiscordWelcomeScreenModel {
+	model := &DiscordWelcomeScreenModel{
+		ServerID:       data.ServerID,
+		Enabled:        types.BoolValue(enabled),
+		Description:    utils.StringValueOrNull(screen.Description),
+		AuditLogReason: data.AuditLogReason,
+	}
+	for _, welcome := range screen.WelcomeChannels {
+		channel := DiscordWelcomeScreenChannelModel{
+			ChannelID:    types.StringValue(welcome.ChannelID),
+			Description:  types.StringValue(welcome.Description),
+			EmojiID:      types.StringNull(),
+			EmojiUnicode: types.StringNull(),
+		}
+		if welcome.EmojiID != nil && *welcome.EmojiID != "" {
+			channel.EmojiID = types.StringValue(*welcome.EmojiID)
+		} else if welcome.EmojiName != nil {
+			channel.EmojiUnicode = utils.StringValueOrNull(*welcome.EmojiName)
+		}
+		model.WelcomeChannel = append(model.WelcomeChannel, channel)
+	}
+
+	return model
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceDiscordWelcomeScreen(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	if testServerID == "" || testChannelID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID and DISCORD_TEST_CHANNEL_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_welcome_screen.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordWelcomeScreen(testServerID, `description = "Welcome"
+  welcome_channel {
+    channel_id = "1"
+    description = "Not a channel of the server"
+  }`),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Channel 1 is not a channel of server %s", testServerID)),
+			},
+			{
+				Config: testAccResourceDiscordWelcomeScreen(testServerID, fmt.Sprintf(`description = "Welcome to the Terraform server"
+  welcome_channel {
+    channel_id = %q
+    description = "Say hello"
+    emoji_unicode = "👋"
+  }
+  welcome_channel {
+    channel_id = discord_text_channel.rules.id
+    description = "Read the rules"
+  }`, testChannelID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "description", "Welcome to the Terraform server"),
+					resource.TestCheckResourceAttr(name, "welcome_channel.#", "2"),
+					resource.TestCheckResourceAttr(name, "welcome_channel.0.channel_id", testChannelID),
+					resource.TestCheckResourceAttr(name, "welcome_channel.0.emoji_unicode", "👋"),
+					resource.TestCheckNoResourceAttr(name, "welcome_channel.0.emoji_id"),
+					resource.TestCheckResourceAttrPair(name, "welcome_channel.1.channel_id", "discord_text_channel.rules", "id"),
+					resource.TestCheckNoResourceAttr(name, "welcome_channel.1.emoji_unicode"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordWelcomeScreen(testServerID, `enabled = false
+  welcome_channel {
+    channel_id = discord_text_channel.rules.id
+    description = "Read the rules"
+  }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(name, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+					resource.TestCheckNoResourceAttr(name, "description"),
+					resource.TestCheckResourceAttr(name, "welcome_channel.#", "1"),
+				),
+			},
+			{
+				ResourceName:                         name,
+				ImportState:                          true,
+				ImportStateId:                        testServerID,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "server_id",
+				ImportStateVerifyIgnore:              []string{"audit_log_reason"},
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordWelcomeScreen(serverID string, screen string) string {
+	return fmt.Sprintf(`
+	resource "discord_text_channel" "rules" {
+	  server_id = "%[1]s"
+	  name = "terraform-welcome-rules"
+	  topic = "Server rules"
+	}
+
+	resource "discord_welcome_screen" "example" {
+	  server_id = "%[1]s"
+	  %[2]s
+	  audit_log_reason = "Managed by Terraform"
+	}`, serverID, screen)
+}
@@ -68,6 +68,10 @@ func DiscordChannelRead(client *discordgo.Session, ctx context.Context, req reso
 	}
 
 	channel, err := client.Channel(data.ChannelID.ValueString(), discordgo.WithContext(ctx))
+	if IsNotFound(err) {
+		RemoveNotFound(ctx, resp, "channel", data.ChannelID.ValueString())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to fetch channel", err.Error())
 		return
@@ -303,6 +307,8 @@ func GetChannelTypeText(channelType discordgo.ChannelType) (string, bool) {
 		return "news", true
 	case 6:
 		return "store", true
+	case 15:
+		return "forum", true
 	}
 
 	return "text", false
@@ -320,6 +326,12 @@ func GetDiscordChannelType(name string) (discordgo.ChannelType, bool) {
 		return discordgo.ChannelTypeGuildNews, true
 	case "store":
 		return discordgo.ChannelTypeGuildStore, true
+	case "stage":
+		return discordgo.ChannelTypeGuildStageVoice, true
+	case "forum":
+		return discordgo.ChannelTypeGuildForum, true
+	case "media":
+		return discordgo.ChannelTypeGuildMedia, true
 	}
 
 	return 0, false
@@ -355,15 +367,16 @@ func ArePermissionsSynced(from *discordgo.Channel, to *discordgo.Channel) bool {
 	return true
 }
 
-func SyncChannelPermissions(c *discordgo.Session, ctx context.Context, from *discordgo.Channel, to *discordgo.Channel) error {
+func SyncChannelPermissions(c *discordgo.Session, ctx context.Context, from *discordgo.Channel, to *discordgo.Channel, options ...discordgo.RequestOption) error {
+	options = append([]discordgo.RequestOption{discordgo.WithContext(ctx)}, options...)
 	for _, p := range to.PermissionOverwrites {
-		if err := c.ChannelPermissionDelete(to.ID, p.ID); err != nil {
+		if err := c.ChannelPermissionDelete(to.ID, p.ID, options...); err != nil {
 			return err
 		}
 	}
 
 	for _, p := range from.PermissionOverwrites {
-		if err := c.ChannelPermissionSet(to.ID, p.ID, discordgo.PermissionOverwriteTypeRole, p.Allow, p.Deny, discordgo.WithContext(ctx)); err != nil {
+		if err := c.ChannelPermissionSet(to.ID, p.ID, discordgo.PermissionOverwriteTypeRole, p.Allow, p.Deny, options...); err != nil {
 			return err
 		}
 	}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/polds/imgbase64"
 )
 
-// DiscordServerModel represents a Discord server. Used by both the data source and the resource.
+// DiscordServerModel represents a Discord server for the data source.
 type DiscordServerModel struct {
 	ServerID                    types.String `tfsdk:"server_id"`
 	Name                        types.String `tfsdk:"name"`
@@ -29,6 +33,113 @@ type DiscordServerModel struct {
 	OwnerID                     types.String `tfsdk:"owner_id"`
 }
 
+// DiscordServerResourceModel is DiscordServerModel with the attributes only the server resources have.
+type DiscordServerResourceModel struct {
+	ServerID                    types.String `tfsdk:"server_id"`
+	Name                        types.String `tfsdk:"name"`
+	Region                      types.String `tfsdk:"region"`
+	DefaultMessageNotifications types.Int64  `tfsdk:"default_message_notifications"`
+	VerificationLevel           types.Int64  `tfsdk:"verification_level"`
+	ExplicitContentFilter       types.Int64  `tfsdk:"explicit_content_filter"`
+	AfkTimeout                  types.Int64  `tfsdk:"afk_timeout"`
+	IconURL                     types.String `tfsdk:"icon_url"`
+	IconDataURI                 types.String `tfsdk:"icon_data_uri"`
+	IconHash                    types.String `tfsdk:"icon_hash"`
+	SplashUrl                   types.String `tfsdk:"splash_url"`
+	SplashDataURI               types.String `tfsdk:"splash_data_uri"`
+	SplashHash                  types.String `tfsdk:"splash_hash"`
+	AfkChannelID                types.String `tfsdk:"afk_channel_id"`
+	OwnerID                     types.String `tfsdk:"owner_id"`
+	TemplateCode                types.String `tfsdk:"template_code"`
+	AuditLogReason              types.String `tfsdk:"audit_log_reason"`
+}
+
+// DiscordManagedServerResourceModel is DiscordServerResourceModel without template_code, which only the server
+// resource has. A managed server usually already exists, so creating it from a template would replace it.
+type DiscordManagedServerResourceModel struct {
+	ServerID                    types.String `tfsdk:"server_id"`
+	Name                        types.String `tfsdk:"name"`
+	Region                      types.String `tfsdk:"region"`
+	DefaultMessageNotifications types.Int64  `tfsdk:"default_message_notifications"`
+	VerificationLevel           types.Int64  `tfsdk:"verification_level"`
+	ExplicitContentFilter       types.Int64  `tfsdk:"explicit_content_filter"`
+	AfkTimeout                  types.Int64  `tfsdk:"afk_timeout"`
+	IconURL                     types.String `tfsdk:"icon_url"`
+	IconDataURI                 types.String `tfsdk:"icon_data_uri"`
+	IconHash                    types.String `tfsdk:"icon_hash"`
+	SplashUrl                   types.String `tfsdk:"splash_url"`
+	SplashDataURI               types.String `tfsdk:"splash_data_uri"`
+	SplashHash                  types.String `tfsdk:"splash_hash"`
+	AfkChannelID                types.String `tfsdk:"afk_channel_id"`
+	OwnerID                     types.String `tfsdk:"owner_id"`
+	AuditLogReason              types.String `tfsdk:"audit_log_reason"`
+}
+
+// serverResourceModelSource is the plan or state a server resource model is read from.
+type serverResourceModelSource interface {
+	Get(ctx context.Context, target interface{}) diag.Diagnostics
+}
+
+// getServerResourceModel reads the model of the server or managed server resource.
+func getServerResourceModel(ctx context.Context, source serverResourceModelSource, managed bool) (*DiscordServerResourceModel, diag.Diagnostics) {
+	if !managed {
+		var data *DiscordServerResourceModel
+		diags := source.Get(ctx, &data)
+		return data, diags
+	}
+	var data *DiscordManagedServerResourceModel
+	diags := source.Get(ctx, &data)
+	if data == nil {
+		return nil, diags
+	}
+
+	return &DiscordServerResourceModel{
+		ServerID:                    data.ServerID,
+		Name:                        data.Name,
+		Region:                      data.Region,
+		DefaultMessageNotifications: data.DefaultMessageNotifications,
+		VerificationLevel:           data.VerificationLevel,
+		ExplicitContentFilter:       data.ExplicitContentFilter,
+		AfkTimeout:                  data.AfkTimeout,
+		IconURL:                     data.IconURL,
+		IconDataURI:                 data.IconDataURI,
+		IconHash:                    data.IconHash,
+		SplashUrl:                   data.SplashUrl,
+		SplashDataURI:               data.SplashDataURI,
+		SplashHash:                  data.SplashHash,
+		AfkChannelID:                data.AfkChannelID,
+		OwnerID:                     data.OwnerID,
+		AuditLogReason:              data.AuditLogReason,
+		TemplateCode:                types.StringNull(),
+	}, diags
+}
+
+// setServerResourceModel saves the model of the server or managed server resource in state.
+func setServerResourceModel(ctx context.Context, state *tfsdk.State, data *DiscordServerResourceModel, managed bool) diag.Diagnostics {
+	if !managed {
+		return state.Set(ctx, data)
+	}
+
+	return state.Set(ctx, &DiscordManagedServerResourceModel{
+		ServerID:                    data.ServerID,
+		Name:                        data.Name,
+		Region:                      data.Region,
+		DefaultMessageNotifications: data.DefaultMessageNotifications,
+		VerificationLevel:           data.VerificationLevel,
+		ExplicitContentFilter:       data.ExplicitContentFilter,
+		AfkTimeout:                  data.AfkTimeout,
+		IconURL:                     data.IconURL,
+		IconDataURI:                 data.IconDataURI,
+		IconHash:                    data.IconHash,
+		SplashUrl:                   data.SplashUrl,
+		SplashDataURI:               data.SplashDataURI,
+		SplashHash:                  data.SplashHash,
+		AfkChannelID:                data.AfkChannelID,
+		OwnerID:                     data.OwnerID,
+		AuditLogReason:              data.AuditLogReason,
+	})
+}
+
 func BuildServerResourceSchema(managed bool) map[string]schema.Attribute {
 	base := map[string]schema.Attribute{
 
@@ -98,6 +209,7 @@ func BuildServerResourceSchema(managed bool) map[string]schema.Attribute {
 			Computed:    true,
 		},
 	}
+	base["audit_log_reason"] = AuditLogReasonAttribute()
 	if managed {
 		base["server_id"] = schema.StringAttribute{
 			Description: "ID of the server. Only one of `server_id` or `name` can be set.",
@@ -116,36 +228,51 @@ func BuildServerResourceSchema(managed bool) map[string]schema.Attribute {
 			Description: "Name of the server.",
 			Required:    true,
 		}
+		base["template_code"] = schema.StringAttribute{
+			Description: "Code of a server template to create the server from, with its roles, channels and settings. Changing it replaces the server.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
 	}
 	return base
 }
 
 // DiscordServerCreate creates a new Discord server. Used by both the server and managed server resource.
-func DiscordServerCreate(client *discordgo.Session, ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data *DiscordServerModel
-
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+func DiscordServerCreate(client *discordgo.Session, ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, managed bool) {
+	data, diags := getServerResourceModel(ctx, req.Plan, managed)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	server, err := client.GuildCreate(data.Name.ValueString(), discordgo.WithContext(ctx))
+	var server *discordgo.Guild
+	var err error
+	if data.TemplateCode.IsNull() {
+		server, err = client.GuildCreate(data.Name.ValueString(), discordgo.WithContext(ctx), WithAuditLogReason(data.AuditLogReason))
+	} else {
+		server, err = client.GuildCreateWithTemplate(data.TemplateCode.ValueString(), data.Name.ValueString(), "", discordgo.WithContext(ctx), WithAuditLogReason(data.AuditLogReason))
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create a server", err.Error())
 		return
 	}
 
 	guildParams := BuildGuildParams(data)
-	server, err = client.GuildEdit(server.ID, guildParams, discordgo.WithContext(ctx))
+	server, err = client.GuildEdit(server.ID, guildParams, discordgo.WithContext(ctx), WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update server", err.Error())
 		return
 	}
-	for _, channel := range server.Channels {
-		if _, err := client.ChannelDelete(channel.ID); err != nil {
-			resp.Diagnostics.AddError("Failed to delete channel", err.Error())
-			return
+	// The channels of a template are kept, the default channels of an empty server are not.
+	if data.TemplateCode.IsNull() {
+		for _, channel := range server.Channels {
+			if _, err := client.ChannelDelete(channel.ID, discordgo.WithContext(ctx), WithAuditLogReason(data.AuditLogReason)); err != nil {
+				resp.Diagnostics.AddError("Failed to delete channel", err.Error())
+				return
+			}
 		}
 	}
 	// Update owner's ID if the specified one is not as same as default,
@@ -155,7 +282,7 @@ func DiscordServerCreate(client *discordgo.Session, ctx context.Context, req res
 		if data.OwnerID.ValueString() != ownerID {
 			ownerID = data.OwnerID.ValueString()
 		}
-		server, err = client.GuildEdit(server.ID, &discordgo.GuildParams{OwnerID: ownerID}, discordgo.WithContext(ctx))
+		server, err = client.GuildEdit(server.ID, &discordgo.GuildParams{OwnerID: ownerID}, discordgo.WithContext(ctx), WithAuditLogReason(data.AuditLogReason))
 		if err != nil {
 			resp.Diagnostics.AddError("Failed to update server owner", err.Error())
 			return
@@ -163,36 +290,38 @@ func DiscordServerCreate(client *discordgo.Session, ctx context.Context, req res
 		}
 	}
 
-	data = BuildServerModel(server)
+	data = BuildServerResourceModel(server, data.TemplateCode, data.AuditLogReason)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setServerResourceModel(ctx, &resp.State, data, managed)...)
 }
 
 // DiscordServerRead reads a Discord server. Used by both the server and managed server resource.
-func DiscordServerRead(client *discordgo.Session, ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data *DiscordServerModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+func DiscordServerRead(client *discordgo.Session, ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, managed bool) {
+	data, diags := getServerResourceModel(ctx, req.State, managed)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	server, err := client.Guild(data.ServerID.ValueString(), discordgo.WithContext(ctx))
+	if IsNotFound(err) {
+		RemoveNotFound(ctx, resp, "server", data.ServerID.ValueString())
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Failed to get server %s", data.Name.ValueString()), err.Error())
 		return
 
 	}
-	data = BuildServerModel(server)
+	data = BuildServerResourceModel(server, data.TemplateCode, data.AuditLogReason)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setServerResourceModel(ctx, &resp.State, data, managed)...)
 }
 
 // DiscordServerUpdate updates a Discord server. Used by both the server and managed server resource.
-func DiscordServerUpdate(client *discordgo.Session, ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *DiscordServerModel
-
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+func DiscordServerUpdate(client *discordgo.Session, ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, managed bool) {
+	data, diags := getServerResourceModel(ctx, req.Plan, managed)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -200,22 +329,21 @@ func DiscordServerUpdate(client *discordgo.Session, ctx context.Context, req res
 
 	guildParams := BuildGuildParams(data)
 
-	server, err := client.GuildEdit(data.ServerID.ValueString(), guildParams, discordgo.WithContext(ctx))
+	server, err := client.GuildEdit(data.ServerID.ValueString(), guildParams, discordgo.WithContext(ctx), WithAuditLogReason(data.AuditLogReason))
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update server", err.Error())
 		return
 
 	}
-	data = BuildServerModel(server)
+	data = BuildServerResourceModel(server, data.TemplateCode, data.AuditLogReason)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setServerResourceModel(ctx, &resp.State, data, managed)...)
 }
 
 // DiscordServerDelete deletes a Discord server. Used by both the server and managed server resource.
-func DiscordServerDelete(client *discordgo.Session, ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data *DiscordServerModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+func DiscordServerDelete(client *discordgo.Session, ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, managed bool) {
+	data, diags := getServerResourceModel(ctx, req.State, managed)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -228,7 +356,7 @@ func DiscordServerDelete(client *discordgo.Session, ctx context.Context, req res
 
 }
 
-func BuildGuildParams(data *DiscordServerModel) *discordgo.GuildParams {
+func BuildGuildParams(data *DiscordServerResourceModel) *discordgo.GuildParams {
 	icon := ""
 	if data.IconURL.ValueString() != "" {
 		icon = imgbase64.FromRemote(data.IconURL.ValueString())
@@ -243,11 +371,16 @@ func BuildGuildParams(data *DiscordServerModel) *discordgo.GuildParams {
 	if data.SplashDataURI.ValueString() != "" {
 		icon = data.SplashDataURI.ValueString()
 	}
-	verificationLevel := discordgo.VerificationLevel(data.VerificationLevel.ValueInt64())
+	// An unknown verification level is left as it is, which keeps the one of a template.
+	var verificationLevel *discordgo.VerificationLevel
+	if !data.VerificationLevel.IsUnknown() && !data.VerificationLevel.IsNull() {
+		level := discordgo.VerificationLevel(data.VerificationLevel.ValueInt64())
+		verificationLevel = &level
+	}
 	return &discordgo.GuildParams{
 		Name:                        data.Name.ValueString(),
 		Region:                      data.Region.ValueString(),
-		VerificationLevel:           &verificationLevel,
+		VerificationLevel:           verificationLevel,
 		DefaultMessageNotifications: int(data.DefaultMessageNotifications.ValueInt64()),
 		AfkChannelID:                data.AfkChannelID.ValueString(),
 		AfkTimeout:                  int(data.AfkTimeout.ValueInt64()),
@@ -275,3 +408,29 @@ func BuildServerModel(server *discordgo.Guild) *DiscordServerModel {
 		OwnerID:                     types.StringValue(server.OwnerID),
 	}
 }
+
+// BuildServerResourceModel builds the resource model of a server. templateCode and auditLogReason are kept from the
+// configuration, as Discord does not return them.
+func BuildServerResourceModel(server *discordgo.Guild, templateCode types.String, auditLogReason types.String) *DiscordServerResourceModel {
+	data := BuildServerModel(server)
+
+	return &DiscordServerResourceModel{
+		ServerID:                    data.ServerID,
+		Name:                        data.Name,
+		Region:                      data.Region,
+		DefaultMessageNotifications: data.DefaultMessageNotifications,
+		VerificationLevel:           data.VerificationLevel,
+		ExplicitContentFilter:       data.ExplicitContentFilter,
+		AfkTimeout:                  data.AfkTimeout,
+		IconURL:                     data.IconURL,
+		IconDataURI:                 data.IconDataURI,
+		IconHash:                    data.IconHash,
+		SplashUrl:                   data.SplashUrl,
+		SplashDataURI:               data.SplashDataURI,
+		SplashHash:                  data.SplashHash,
+		AfkChannelID:                data.AfkChannelID,
+		OwnerID:                     data.OwnerID,
+		TemplateCode:                templateCode,
+		AuditLogReason:              auditLogReason,
+	}
+}
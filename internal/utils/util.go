@@ -1,6 +1,11 @@
 package utils
 
-import "hash/crc32"
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"hash/crc32"
+)
 
 func Hashcode(s string) int {
 	v := int(crc32.ChecksumIEEE([]byte(s)))
@@ -23,3 +28,16 @@ func Contains[T comparable](array []T, value T) bool {
 
 	return false
 }
+
+// StringSetOrNull returns a list of IDs or names as a set. Discord returns empty lists for everything that is not
+// set, so they are null unless the configuration has an empty set.
+func StringSetOrNull(ctx context.Context, values []string, configured types.Set) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 && (configured.IsNull() || configured.IsUnknown()) {
+		return types.SetNull(types.StringType), nil
+	}
+	if values == nil {
+		values = []string{}
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
@@ -0,0 +1,174 @@
+package utils
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+)
+
+// discordgo predates the integration types and contexts of application commands, and drops maximum values and
+// lengths of 0, so commands are sent and read here.
+
+// ApplicationIntegrationType is where an application can be installed.
+type ApplicationIntegrationType int
+
+const (
+	ApplicationIntegrationGuildInstall ApplicationIntegrationType = 0
+	ApplicationIntegrationUserInstall  ApplicationIntegrationType = 1
+)
+
+// InteractionContextType is where a command can be used.
+type InteractionContextType int
+
+const (
+	InteractionContextGuild          InteractionContextType = 0
+	InteractionContextBotDM          InteractionContextType = 1
+	InteractionContextPrivateChannel InteractionContextType = 2
+)
+
+// ApplicationCommandOptionChoice is a predefined value of an option. Value is a string, or a number for integer
+// and number options.
+type ApplicationCommandOptionChoice struct {
+	Name              string                      `json:"name"`
+	NameLocalizations map[discordgo.Locale]string `json:"name_localizations,omitempty"`
+	Value             any                         `json:"value"`
+}
+
+// ApplicationCommandOption is an option, subcommand or subcommand group of a chat input command.
+type ApplicationCommandOption struct {
+	Type                     discordgo.ApplicationCommandOptionType `json:"type"`
+	Name                     string                                 `json:"name"`
+	NameLocalizations        map[discordgo.Locale]string            `json:"name_localizations,omitempty"`
+	Description              string                                 `json:"description"`
+	DescriptionLocalizations map[discordgo.Locale]string            `json:"description_localizations,omitempty"`
+	Required                 bool                                   `json:"required,omitempty"`
+	Choices                  []ApplicationCommandOptionChoice       `json:"choices,omitempty"`
+	Options                  []ApplicationCommandOption             `json:"options,omitempty"`
+	ChannelTypes             []discordgo.ChannelType                `json:"channel_types,omitempty"`
+	MinValue                 *float64                               `json:"min_value,omitempty"`
+	MaxValue                 *float64                               `json:"max_value,omitempty"`
+	MinLength                *int                                   `json:"min_length,omitempty"`
+	MaxLength                *int                                   `json:"max_length,omitempty"`
+	Autocomplete             bool                                   `json:"autocomplete,omitempty"`
+}
+
+// ApplicationCommand is a slash, user or message command. The localizations, default member permissions and
+// contexts are always sent, so that they can be removed. Options are sent unless they are nil, as Discord keeps the
+// options of a command that are left out.
+type ApplicationCommand struct {
+	ID                       string                           `json:"id,omitempty"`
+	ApplicationID            string                           `json:"application_id,omitempty"`
+	GuildID                  string                           `json:"guild_id,omitempty"`
+	Version                  string                           `json:"version,omitempty"`
+	Type                     discordgo.ApplicationCommandType `json:"type,omitempty"`
+	Name                     string                           `json:"name"`
+	NameLocalizations        map[discordgo.Locale]string      `json:"name_localizations"`
+	Description              string                           `json:"description"`
+	DescriptionLocalizations map[discordgo.Locale]string      `json:"description_localizations"`
+	Options                  []ApplicationCommandOption       `json:"options"`
+	DefaultMemberPermissions *string                          `json:"default_member_permissions"`
+	NSFW                     bool                             `json:"nsfw"`
+	IntegrationTypes         []ApplicationIntegrationType     `json:"integration_types,omitempty"`
+	Contexts                 []InteractionContextType         `json:"contexts"`
+}
+
+// MarshalJSON leaves out nil Options, which user and message commands cannot have.
+func (c ApplicationCommand) MarshalJSON() ([]byte, error) {
+	type applicationCommand ApplicationCommand
+	if c.Options != nil {
+		return json.Marshal(applicationCommand(c))
+	}
+
+	return json.Marshal(struct {
+		applicationCommand
+		Options []ApplicationCommandOption `json:"options,omitempty"`
+	}{applicationCommand: applicationCommand(c)})
+}
+
+// applicationCommandsEndpoint returns the endpoint of the global commands of an application, or of its commands in
+// a server when guildID is set.
+func applicationCommandsEndpoint(appID string, guildID string) string {
+	if guildID != "" {
+		return discordgo.EndpointApplicationGuildCommands(appID, guildID)
+	}
+
+	return discordgo.EndpointApplicationGlobalCommands(appID)
+}
+
+// GetApplicationCommands returns the commands of an application with all their localizations.
+func GetApplicationCommands(client *discordgo.Session, appID string, guildID string, options ...discordgo.RequestOption) ([]ApplicationCommand, error) {
+	endpoint := applicationCommandsEndpoint(appID, guildID)
+	body, err := client.RequestWithBucketID("GET", endpoint+"?with_localizations=true", nil, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+	var commands []ApplicationCommand
+	if err := json.Unmarshal(body, &commands); err != nil {
+		return nil, err
+	}
+
+	return commands, nil
+}
+
+// GetApplicationCommand returns a command of an application.
+func GetApplicationCommand(client *discordgo.Session, appID string, guildID string, commandID string, options ...discordgo.RequestOption) (*ApplicationCommand, error) {
+	endpoint := applicationCommandsEndpoint(appID, guildID)
+	body, err := client.RequestWithBucketID("GET", endpoint+"/"+commandID, nil, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalApplicationCommand(body)
+}
+
+// ApplicationCommandCreateComplex creates a command. Discord replaces the command of the same name and type
+// instead, if there is one.
+func ApplicationCommandCreateComplex(client *discordgo.Session, appID string, guildID string, data *ApplicationCommand, options ...discordgo.RequestOption) (*ApplicationCommand, error) {
+	endpoint := applicationCommandsEndpoint(appID, guildID)
+	body, err := client.RequestWithBucketID("POST", endpoint, data, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalApplicationCommand(body)
+}
+
+// ApplicationCommandEditComplex edits a command. The type of a command cannot be changed, so it is left out.
+func ApplicationCommandEditComplex(client *discordgo.Session, appID string, guildID string, commandID string, data *ApplicationCommand, options ...discordgo.RequestOption) (*ApplicationCommand, error) {
+	endpoint := applicationCommandsEndpoint(appID, guildID)
+	edit := *data
+	edit.Type = 0
+	body, err := client.RequestWithBucketID("PATCH", endpoint+"/"+commandID, edit, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalApplicationCommand(body)
+}
+
+// ApplicationCommandBulkOverwriteComplex replaces all the commands of an application with commands. Commands keep
+// their ID when one of the same name and type already exists.
+func ApplicationCommandBulkOverwriteComplex(client *discordgo.Session, appID string, guildID string, commands []ApplicationCommand, options ...discordgo.RequestOption) ([]ApplicationCommand, error) {
+	endpoint := applicationCommandsEndpoint(appID, guildID)
+	if commands == nil {
+		commands = []ApplicationCommand{}
+	}
+	body, err := client.RequestWithBucketID("PUT", endpoint, commands, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+	var overwritten []ApplicationCommand
+	if err := json.Unmarshal(body, &overwritten); err != nil {
+		return nil, err
+	}
+
+	return overwritten, nil
+}
+
+func unmarshalApplicationCommand(body []byte) (*ApplicationCommand, error) {
+	command := &ApplicationCommand{}
+	if err := json.Unmarshal(body, command); err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
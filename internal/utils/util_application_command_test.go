@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+	"testing"
+)
+
+func TestApplicationCommandMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		command ApplicationCommand
+		want    string
+	}{
+		{
+			name:    "user command",
+			command: ApplicationCommand{Type: discordgo.UserApplicationCommand, Name: "Report"},
+			want:    `{"type":2,"name":"Report","name_localizations":null,"description":"","description_localizations":null,"default_member_permissions":null,"nsfw":false,"contexts":null}`,
+		},
+		{
+			name:    "no options",
+			command: ApplicationCommand{Name: "ping", Description: "Ping", Options: []ApplicationCommandOption{}},
+			want:    `{"name":"ping","name_localizations":null,"description":"Ping","description_localizations":null,"options":[],"default_member_permissions":null,"nsfw":false,"contexts":null}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := json.Marshal(test.command)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != test.want {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
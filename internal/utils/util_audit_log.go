@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"net/url"
+)
+
+const auditLogReasonHeader = "X-Audit-Log-Reason"
+
+// AuditLogReasonAttribute is the per-resource override of the provider audit_log_reason.
+func AuditLogReasonAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Reason shown in the server audit log for changes made by this resource. Overrides the provider `audit_log_reason`.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 512),
+		},
+	}
+}
+
+// WithAuditLogReason sets the audit log reason of a request when reason is set. Requests without one fall back to the
+// provider default added by AuditLogTransport.
+func WithAuditLogReason(reason types.String) discordgo.RequestOption {
+	return func(cfg *discordgo.RequestConfig) {
+		if reason.ValueString() != "" {
+			cfg.Request.Header.Set(auditLogReasonHeader, url.PathEscape(reason.ValueString()))
+		}
+	}
+}
+
+// AuditLogTransport adds Reason to every request to the Discord API that changes something and does not have a reason
+// of its own.
+type AuditLogTransport struct {
+	Reason string
+	Next   http.RoundTripper
+}
+
+func (t *AuditLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Reason != "" && isDiscordRequest(req) && req.Method != http.MethodGet && req.Method != http.MethodHead && req.Header.Get(auditLogReasonHeader) == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(auditLogReasonHeader, url.PathEscape(t.Reason))
+	}
+
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return next.RoundTrip(req)
+}
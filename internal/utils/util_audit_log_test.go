@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestAuditLogReason(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get(auditLogReasonHeader)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	session, err := discordgo.New("Bot test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session.Client = &http.Client{Transport: &AuditLogTransport{Reason: "Managed by Terraform", Next: &BaseURLTransport{BaseURL: baseURL}}}
+
+	tests := []struct {
+		name    string
+		url     string
+		method  string
+		options []discordgo.RequestOption
+		want    string
+	}{
+		{
+			name:   "provider default",
+			method: http.MethodPatch,
+			want:   "Managed%20by%20Terraform",
+		},
+		{
+			name:    "resource override",
+			method:  http.MethodPatch,
+			options: []discordgo.RequestOption{WithAuditLogReason(types.StringValue("Rotating roles"))},
+			want:    "Rotating%20roles",
+		},
+		{
+			name:    "null override",
+			method:  http.MethodDelete,
+			options: []discordgo.RequestOption{WithAuditLogReason(types.StringNull())},
+			want:    "Managed%20by%20Terraform",
+		},
+		{
+			name:   "read",
+			method: http.MethodGet,
+			want:   "",
+		},
+		{
+			name:   "other host",
+			url:    server.URL,
+			method: http.MethodPost,
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = ""
+			endpoint := discordgo.EndpointUsers + "@me"
+			if tt.url != "" {
+				endpoint = tt.url
+			}
+			if _, err := session.RequestWithBucketID(tt.method, endpoint, nil, endpoint, tt.options...); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got audit log reason %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+)
+
+// discordgo predates the mention spam and member profile triggers, and cannot send empty exempt lists, so AutoMod
+// rules are sent and read here.
+
+const (
+	// AutoModerationEventMemberUpdate is checked when a member joins or edits their profile.
+	AutoModerationEventMemberUpdate discordgo.AutoModerationRuleEventType = 2
+
+	AutoModerationEventTriggerMentionSpam   discordgo.AutoModerationRuleTriggerType = 5
+	AutoModerationEventTriggerMemberProfile discordgo.AutoModerationRuleTriggerType = 6
+
+	// AutoModerationRuleActionBlockMemberInteraction stops a member from using text, voice or other interactions.
+	AutoModerationRuleActionBlockMemberInteraction discordgo.AutoModerationActionType = 4
+)
+
+// AutoModerationTriggerMetadata is a discordgo.AutoModerationTriggerMetadata with the mention raid protection.
+type AutoModerationTriggerMetadata struct {
+	KeywordFilter                []string                                `json:"keyword_filter,omitempty"`
+	RegexPatterns                []string                                `json:"regex_patterns,omitempty"`
+	Presets                      []discordgo.AutoModerationKeywordPreset `json:"presets,omitempty"`
+	AllowList                    []string                                `json:"allow_list,omitempty"`
+	MentionTotalLimit            int                                     `json:"mention_total_limit,omitempty"`
+	MentionRaidProtectionEnabled bool                                    `json:"mention_raid_protection_enabled,omitempty"`
+}
+
+// AutoModerationActionMetadata is a discordgo.AutoModerationActionMetadata with the custom block message.
+type AutoModerationActionMetadata struct {
+	ChannelID     string `json:"channel_id,omitempty"`
+	Duration      int    `json:"duration_seconds,omitempty"`
+	CustomMessage string `json:"custom_message,omitempty"`
+}
+
+// AutoModerationAction is what Discord does when a rule is triggered.
+type AutoModerationAction struct {
+	Type     discordgo.AutoModerationActionType `json:"type"`
+	Metadata *AutoModerationActionMetadata      `json:"metadata,omitempty"`
+}
+
+// AutoModerationRule is an AutoMod rule. The exempt lists are always sent, so that they can be emptied. The trigger
+// type cannot be changed, so it is left out of edits.
+type AutoModerationRule struct {
+	ID              string                                  `json:"id,omitempty"`
+	GuildID         string                                  `json:"guild_id,omitempty"`
+	Name            string                                  `json:"name"`
+	CreatorID       string                                  `json:"creator_id,omitempty"`
+	EventType       discordgo.AutoModerationRuleEventType   `json:"event_type"`
+	TriggerType     discordgo.AutoModerationRuleTriggerType `json:"trigger_type,omitempty"`
+	TriggerMetadata *AutoModerationTriggerMetadata          `json:"trigger_metadata,omitempty"`
+	Actions         []AutoModerationAction                  `json:"actions"`
+	Enabled         bool                                    `json:"enabled"`
+	ExemptRoles     []string                                `json:"exempt_roles"`
+	ExemptChannels  []string                                `json:"exempt_channels"`
+}
+
+// GetAutoModerationRule returns an AutoMod rule of a server.
+func GetAutoModerationRule(client *discordgo.Session, guildID string, ruleID string, options ...discordgo.RequestOption) (*AutoModerationRule, error) {
+	endpoint := discordgo.EndpointGuildAutoModerationRule(guildID, ruleID)
+	body, err := client.RequestWithBucketID("GET", endpoint, nil, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalAutoModerationRule(body)
+}
+
+// AutoModerationRuleCreateComplex creates an AutoMod rule.
+func AutoModerationRuleCreateComplex(client *discordgo.Session, guildID string, data *AutoModerationRule, options ...discordgo.RequestOption) (*AutoModerationRule, error) {
+	endpoint := discordgo.EndpointGuildAutoModerationRules(guildID)
+	body, err := client.RequestWithBucketID("POST", endpoint, withExemptLists(data), endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalAutoModerationRule(body)
+}
+
+// AutoModerationRuleEditComplex edits an AutoMod rule, including emptying its exempt roles and channels.
+func AutoModerationRuleEditComplex(client *discordgo.Session, guildID string, ruleID string, data *AutoModerationRule, options ...discordgo.RequestOption) (*AutoModerationRule, error) {
+	edit := *withExemptLists(data)
+	edit.TriggerType = 0
+	endpoint := discordgo.EndpointGuildAutoModerationRule(guildID, ruleID)
+	body, err := client.RequestWithBucketID("PATCH", endpoint, &edit, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalAutoModerationRule(body)
+}
+
+func withExemptLists(data *AutoModerationRule) *AutoModerationRule {
+	if data.ExemptRoles == nil {
+		data.ExemptRoles = []string{}
+	}
+	if data.ExemptChannels == nil {
+		data.ExemptChannels = []string{}
+	}
+
+	return data
+}
+
+func unmarshalAutoModerationRule(body []byte) (*AutoModerationRule, error) {
+	rule := &AutoModerationRule{}
+	if err := json.Unmarshal(body, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"github.com/bwmarrin/discordgo"
+)
+
+// banPageSize is the most bans Discord returns in one request.
+const banPageSize = 1000
+
+// BanCreate is the body of a ban. discordgo only supports the deprecated delete_message_days.
+type BanCreate struct {
+	DeleteMessageSeconds int `json:"delete_message_seconds,omitempty"`
+}
+
+// BanCreateComplex bans a user from a server. The reason of the ban is the audit log reason of the request.
+func BanCreateComplex(client *discordgo.Session, guildID string, userID string, data *BanCreate, options ...discordgo.RequestOption) error {
+	_, err := client.RequestWithBucketID("PUT", discordgo.EndpointGuildBan(guildID, userID), data, discordgo.EndpointGuildBan(guildID, ""), options...)
+
+	return err
+}
+
+// GetAllBans returns every ban of a server, fetching as many pages as needed.
+func GetAllBans(client *discordgo.Session, guildID string, options ...discordgo.RequestOption) ([]*discordgo.GuildBan, error) {
+	var bans []*discordgo.GuildBan
+	after := ""
+	for {
+		page, err := client.GuildBans(guildID, banPageSize, "", after, options...)
+		if err != nil {
+			return nil, err
+		}
+		bans = append(bans, page...)
+		if len(page) < banPageSize {
+			return bans, nil
+		}
+		after = page[len(page)-1].User.ID
+	}
+}
@@ -18,8 +18,12 @@ func GetTextChannelType(channelType discordgo.ChannelType) (string, bool) {
 		return "news", true
 	case 6:
 		return "store", true
-	case 7:
+	case 13:
+		return "stage", true
+	case 15:
 		return "forum", true
+	case 16:
+		return "media", true
 	}
 
 	return "text", false
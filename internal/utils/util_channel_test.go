@@ -17,7 +17,10 @@ func TestGetTextChannelType(t *testing.T) {
 		{id: 4, chType: "category", isHit: true},
 		{id: 5, chType: "news", isHit: true},
 		{id: 6, chType: "store", isHit: true},
+		{id: 15, chType: "forum", isHit: true},
+		{id: 16, chType: "media", isHit: true},
 		// failure values
+		{id: 7, chType: "text", isHit: false},
 		{id: 10, chType: "text", isHit: false},
 		{id: 100, chType: "text", isHit: false},
 	}
@@ -46,6 +49,7 @@ func TestGetDiscordChannelType(t *testing.T) {
 		{chType: 4, name: "category", isHit: true},
 		{chType: 5, name: "news", isHit: true},
 		{chType: 6, name: "store", isHit: true},
+		{chType: 15, name: "forum", isHit: true},
 		// failure values
 		{chType: 0, name: "lorem", isHit: false},
 		{chType: 0, name: "pesudo", isHit: false},
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+)
+
+// EmojiEdit is a discordgo.EmojiParams that can also remove every role restriction. discordgo does not send an
+// empty role list.
+type EmojiEdit struct {
+	Name  string   `json:"name,omitempty"`
+	Roles []string `json:"roles"`
+}
+
+// EmojiEditComplex edits an emoji, including making it available to everyone again.
+func EmojiEditComplex(client *discordgo.Session, guildID string, emojiID string, data *EmojiEdit, options ...discordgo.RequestOption) (*discordgo.Emoji, error) {
+	if data.Roles == nil {
+		data.Roles = []string{}
+	}
+	body, err := client.RequestWithBucketID("PATCH", discordgo.EndpointGuildEmoji(guildID, emojiID), data, discordgo.EndpointGuildEmojis(guildID), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	emoji := &discordgo.Emoji{}
+	if err := json.Unmarshal(body, emoji); err != nil {
+		return nil, err
+	}
+
+	return emoji, nil
+}
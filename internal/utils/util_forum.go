@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ChannelFlagHideMediaDownloadOptions hides the embedded media download options of a media channel. discordgo does
+// not define it.
+const ChannelFlagHideMediaDownloadOptions discordgo.ChannelFlags = 1 << 15
+
+// DiscordForumTagModel represents a tag that can be applied to posts in a forum channel.
+type DiscordForumTagModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Moderated types.Bool   `tfsdk:"moderated"`
+	EmojiID   types.String `tfsdk:"emoji_id"`
+	EmojiName types.String `tfsdk:"emoji_name"`
+}
+
+// ForumChannel is a discordgo.Channel with the forum fields discordgo does not decode.
+type ForumChannel struct {
+	*discordgo.Channel
+	DefaultAutoArchiveDuration int `json:"default_auto_archive_duration"`
+}
+
+// ForumChannelEdit is a discordgo.ChannelEdit with the forum fields discordgo does not send.
+// DefaultReactionEmoji is always sent so that it can be cleared.
+type ForumChannelEdit struct {
+	discordgo.ChannelEdit
+	DefaultReactionEmoji       *discordgo.ForumDefaultReaction `json:"default_reaction_emoji"`
+	DefaultAutoArchiveDuration int                             `json:"default_auto_archive_duration,omitempty"`
+}
+
+// GetForumChannel fetches a channel including the forum only fields.
+func GetForumChannel(client *discordgo.Session, channelID string, options ...discordgo.RequestOption) (*ForumChannel, error) {
+	body, err := client.RequestWithBucketID("GET", discordgo.EndpointChannel(channelID), nil, discordgo.EndpointChannel(channelID), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalForumChannel(body)
+}
+
+// ForumChannelEditComplex edits a channel including the forum only fields.
+func ForumChannelEditComplex(client *discordgo.Session, channelID string, data *ForumChannelEdit, options ...discordgo.RequestOption) (*ForumChannel, error) {
+	body, err := client.RequestWithBucketID("PATCH", discordgo.EndpointChannel(channelID), data, discordgo.EndpointChannel(channelID), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalForumChannel(body)
+}
+
+func unmarshalForumChannel(body []byte) (*ForumChannel, error) {
+	channel := &ForumChannel{Channel: &discordgo.Channel{}}
+	if err := json.Unmarshal(body, channel); err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+// BuildForumTags converts the tag models into discordgo tags. Tags that already exist on the channel keep their ID
+// so posts using them are not untagged.
+func BuildForumTags(tags []DiscordForumTagModel, existing []discordgo.ForumTag) []discordgo.ForumTag {
+	forumTags := make([]discordgo.ForumTag, 0, len(tags))
+	for _, tag := range tags {
+		forumTag := discordgo.ForumTag{
+			Name:      tag.Name.ValueString(),
+			Moderated: tag.Moderated.ValueBool(),
+			EmojiID:   tag.EmojiID.ValueString(),
+			EmojiName: tag.EmojiName.ValueString(),
+		}
+		for _, e := range existing {
+			if e.Name == forumTag.Name {
+				forumTag.ID = e.ID
+				break
+			}
+		}
+		forumTags = append(forumTags, forumTag)
+	}
+
+	return forumTags
+}
+
+func BuildForumTagModels(tags []discordgo.ForumTag) []DiscordForumTagModel {
+	models := make([]DiscordForumTagModel, 0, len(tags))
+	for _, tag := range tags {
+		models = append(models, DiscordForumTagModel{
+			ID:        types.StringValue(tag.ID),
+			Name:      types.StringValue(tag.Name),
+			Moderated: types.BoolValue(tag.Moderated),
+			EmojiID:   StringValueOrNull(tag.EmojiID),
+			EmojiName: StringValueOrNull(tag.EmojiName),
+		})
+	}
+
+	return models
+}
+
+func GetForumSortOrderType(value string) (*discordgo.ForumSortOrderType, bool) {
+	var sortOrder discordgo.ForumSortOrderType
+	switch value {
+	case "latest_activity":
+		sortOrder = discordgo.ForumSortOrderLatestActivity
+	case "creation_date":
+		sortOrder = discordgo.ForumSortOrderCreationDate
+	default:
+		return nil, false
+	}
+
+	return &sortOrder, true
+}
+
+func GetForumSortOrderTypeString(value *discordgo.ForumSortOrderType) (string, bool) {
+	if value == nil {
+		return "", false
+	}
+	switch *value {
+	case discordgo.ForumSortOrderLatestActivity:
+		return "latest_activity", true
+	case discordgo.ForumSortOrderCreationDate:
+		return "creation_date", true
+	default:
+		return "", false
+	}
+}
+
+func GetForumLayout(value string) (discordgo.ForumLayout, bool) {
+	switch value {
+	case "not_set":
+		return discordgo.ForumLayoutNotSet, true
+	case "list":
+		return discordgo.ForumLayoutListView, true
+	case "gallery":
+		return discordgo.ForumLayoutGalleryView, true
+	default:
+		return 0, false
+	}
+}
+
+func GetForumLayoutString(value discordgo.ForumLayout) (string, bool) {
+	switch value {
+	case discordgo.ForumLayoutNotSet:
+		return "not_set", true
+	case discordgo.ForumLayoutListView:
+		return "list", true
+	case discordgo.ForumLayoutGalleryView:
+		return "gallery", true
+	default:
+		return "", false
+	}
+}
+
+// StringValueOrNull returns a null string for empty values so optional attributes stay unset.
+func StringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// BaseURLTransport sends requests meant for the Discord API to BaseURL instead. discordgo builds every endpoint
+// from package level variables, so rewriting the request is the only way to point a single session somewhere else.
+type BaseURLTransport struct {
+	BaseURL *url.URL
+	Next    http.RoundTripper
+}
+
+func (t *BaseURLTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isDiscordRequest(req) {
+		req = req.Clone(req.Context())
+		req.URL.Scheme = t.BaseURL.Scheme
+		req.URL.Host = t.BaseURL.Host
+		req.URL.Path = strings.TrimSuffix(t.BaseURL.Path, "/") + req.URL.Path
+		req.URL.RawPath = ""
+		req.Host = t.BaseURL.Host
+	}
+
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return next.RoundTrip(req)
+}
+
+// isDiscordRequest reports whether the request is meant for the Discord API. Transports in front of BaseURLTransport
+// still see the Discord host when api_url points the session somewhere else.
+func isDiscordRequest(req *http.Request) bool {
+	discordURL, err := url.Parse(discordgo.EndpointDiscord)
+	if err != nil {
+		return false
+	}
+
+	return req.URL.Host == discordURL.Host
+}
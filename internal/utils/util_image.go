@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// LottieFormat is the MIME type given to Lottie animations, which are JSON documents.
+const LottieFormat = "application/json"
+
+// maxImageDownload is the most bytes read from an image URL. It is well above every Discord upload limit, so larger
+// images still fail validation with a useful message.
+const maxImageDownload = 16 << 20
+
+// ImageSource is where an image is read from. Only one of the fields is expected to be set.
+type ImageSource struct {
+	File    string
+	DataURI string
+	URL     string
+}
+
+// LoadImage reads the image from its source. URLs are downloaded with client, so the provider proxy and CA bundle
+// settings apply to them.
+func LoadImage(ctx context.Context, client *http.Client, source ImageSource) ([]byte, error) {
+	switch {
+	case source.File != "":
+		return os.ReadFile(source.File)
+	case source.DataURI != "":
+		return DecodeDataURI(source.DataURI)
+	case source.URL != "":
+		return downloadImage(ctx, client, source.URL)
+	}
+
+	return nil, errors.New("no image file, data URI or URL is set")
+}
+
+func downloadImage(ctx context.Context, client *http.Client, imageURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading %s returned %s", imageURL, resp.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxImageDownload))
+}
+
+// DecodeDataURI returns the content of a data URI, such as the ones made by the discord_local_image data source.
+func DecodeDataURI(uri string) ([]byte, error) {
+	header, data, ok := strings.Cut(uri, ",")
+	if !ok || !strings.HasPrefix(header, "data:") {
+		return nil, errors.New("invalid data URI, expected data:<media type>;base64,<data>")
+	}
+	if strings.HasSuffix(header, ";base64") {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	decoded, err := url.PathUnescape(data)
+
+	return []byte(decoded), err
+}
+
+// ImageFormat returns the MIME type of an image, or LottieFormat for a Lottie animation.
+func ImageFormat(data []byte) string {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) && json.Valid(data) {
+		return LottieFormat
+	}
+
+	return http.DetectContentType(data)
+}
+
+// ValidateImage checks that an image is no larger than maxSize bytes and is one of formats, given as MIME types. It
+// returns the MIME type of the image.
+func ValidateImage(data []byte, maxSize int, formats ...string) (string, error) {
+	if len(data) > maxSize {
+		return "", fmt.Errorf("the image is %s, larger than the %s limit", formatSize(len(data)), formatSize(maxSize))
+	}
+	format := ImageFormat(data)
+	for _, allowed := range formats {
+		if format == allowed {
+			return format, nil
+		}
+	}
+
+	return "", fmt.Errorf("the image is %s, expected one of %s", format, strings.Join(formats, ", "))
+}
+
+// ImageDimensions returns the width and height of a PNG, JPEG or GIF image, or of a Lottie animation.
+func ImageDimensions(data []byte) (int, int, error) {
+	if ImageFormat(data) == LottieFormat {
+		var animation struct {
+			Width  *int `json:"w"`
+			Height *int `json:"h"`
+		}
+		if err := json.Unmarshal(data, &animation); err != nil {
+			return 0, 0, err
+		}
+		if animation.Width == nil || animation.Height == nil {
+			return 0, 0, errors.New("the Lottie animation has no width or height")
+		}
+
+		return *animation.Width, *animation.Height, nil
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return config.Width, config.Height, nil
+}
+
+func formatSize(size int) string {
+	if size%1024 == 0 {
+		return fmt.Sprintf("%d KiB", size/1024)
+	}
+
+	return fmt.Sprintf("%.1f KiB", float64(size)/1024)
+}
+
+// ImageHash returns the SHA-256 hash of an image, used to tell when the image has changed without uploading it.
+func ImageHash(data []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+// ImageDataURI encodes an image as the base64 data URI Discord expects for uploads.
+func ImageDataURI(format string, data []byte) string {
+	return fmt.Sprintf("data:%s;base64,%s", format, base64.StdEncoding.EncodeToString(data))
+}
+
+// ModifyPlanImageHash plans the hash of the image returned by load at hashPath, and replaces the resource when the
+// hash changes. Discord cannot change the image of emojis and stickers, so this is the only way to update it. known
+// is false while the image source is only known at apply time. Resources without a hash in their state, such as
+// imported ones, keep the image they have.
+func ModifyPlanImageHash(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, hashPath path.Path, known bool, load func() ([]byte, diag.Diagnostics)) {
+	// Nothing to plan for a destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	hash := types.StringUnknown()
+	if known {
+		data, diags := load()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		hash = types.StringValue(ImageHash(data))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, hashPath, hash)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var current types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, hashPath, &current)...)
+	if !current.IsNull() && !current.Equal(hash) {
+		resp.RequiresReplace = append(resp.RequiresReplace, hashPath)
+	}
+}
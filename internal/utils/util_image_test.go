@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testPNG is the smallest valid PNG header, which is all content sniffing looks at.
+var testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestLoadImage(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "emoji.png")
+	if err := os.WriteFile(file, testPNG, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/emoji.png" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(testPNG)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		source  ImageSource
+		wantErr bool
+	}{
+		{name: "file", source: ImageSource{File: file}},
+		{name: "data URI", source: ImageSource{DataURI: ImageDataURI("image/png", testPNG)}},
+		{name: "URL", source: ImageSource{URL: server.URL + "/emoji.png"}},
+		{name: "missing file", source: ImageSource{File: file + ".missing"}, wantErr: true},
+		{name: "invalid data URI", source: ImageSource{DataURI: "image/png;base64,AAAA"}, wantErr: true},
+		{name: "URL not found", source: ImageSource{URL: server.URL + "/missing.png"}, wantErr: true},
+		{name: "nothing", source: ImageSource{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := LoadImage(context.Background(), server.Client(), tt.source)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadImage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !bytes.Equal(data, testPNG) {
+				t.Errorf("LoadImage() = %q, want %q", data, testPNG)
+			}
+		})
+	}
+}
+
+func TestDecodeDataURIWithoutBase64(t *testing.T) {
+	data, err := DecodeDataURI("data:application/json,%7B%22v%22%3A1%7D")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"v":1}` {
+		t.Errorf("DecodeDataURI() = %q", data)
+	}
+}
+
+func TestValidateImage(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr string
+	}{
+		{name: "valid", data: testPNG},
+		{name: "too large", data: append(append([]byte{}, testPNG...), make([]byte, 1024)...), wantErr: "larger than the 1 KiB limit"},
+		{name: "wrong format", data: []byte("GIF89a"), wantErr: "the image is image/gif"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := ValidateImage(tt.data, 1024, "image/png", "image/jpeg")
+			if tt.wantErr == "" {
+				if err != nil || format != "image/png" {
+					t.Errorf("ValidateImage() = %q, %v", format, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateImage() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestImageHash(t *testing.T) {
+	if ImageHash(testPNG) != ImageHash(append([]byte{}, testPNG...)) {
+		t.Error("expected the same image to have the same hash")
+	}
+	if ImageHash(testPNG) == ImageHash([]byte("GIF89a")) {
+		t.Error("expected different images to have different hashes")
+	}
+}
+
+func TestImageDimensions(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 320, 240))); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		data       []byte
+		wantFormat string
+		wantWidth  int
+		wantHeight int
+		wantErr    bool
+	}{
+		{name: "PNG", data: buf.Bytes(), wantFormat: "image/png", wantWidth: 320, wantHeight: 240},
+		{name: "Lottie", data: []byte(`{"v":"5.5.2","w":320,"h":320,"layers":[]}`), wantFormat: LottieFormat, wantWidth: 320, wantHeight: 320},
+		{name: "Lottie without size", data: []byte(`{"v":"5.5.2"}`), wantFormat: LottieFormat, wantErr: true},
+		{name: "truncated PNG", data: testPNG, wantFormat: "image/png", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if format := ImageFormat(tt.data); format != tt.wantFormat {
+				t.Errorf("ImageFormat() = %q, want %q", format, tt.wantFormat)
+			}
+			width, height, err := ImageDimensions(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ImageDimensions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if width != tt.wantWidth || height != tt.wantHeight {
+				t.Errorf("ImageDimensions() = %dx%d, want %dx%d", width, height, tt.wantWidth, tt.wantHeight)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -13,3 +14,51 @@ func HasRole(member *discordgo.Member, roleId string) bool {
 
 	return false
 }
+
+// MemberEdit is a discordgo.GuildMemberParams that can also reset the nickname. discordgo does not send an empty
+// nickname.
+type MemberEdit struct {
+	Nick      *string `json:"nick,omitempty"`
+	ChannelID *string `json:"channel_id,omitempty"`
+	Mute      *bool   `json:"mute,omitempty"`
+	Deaf      *bool   `json:"deaf,omitempty"`
+	// CommunicationDisabledUntil is an RFC 3339 timestamp. An empty string removes the timeout.
+	CommunicationDisabledUntil *string `json:"-"`
+}
+
+// MarshalJSON sends an empty CommunicationDisabledUntil as null.
+func (e MemberEdit) MarshalJSON() ([]byte, error) {
+	type memberEdit MemberEdit
+	v := struct {
+		memberEdit
+		CommunicationDisabledUntil json.RawMessage `json:"communication_disabled_until,omitempty"`
+	}{memberEdit: memberEdit(e)}
+	if e.CommunicationDisabledUntil != nil {
+		if *e.CommunicationDisabledUntil == "" {
+			v.CommunicationDisabledUntil = json.RawMessage(`null`)
+		} else {
+			timestamp, err := json.Marshal(*e.CommunicationDisabledUntil)
+			if err != nil {
+				return nil, err
+			}
+			v.CommunicationDisabledUntil = timestamp
+		}
+	}
+
+	return json.Marshal(v)
+}
+
+// MemberEditComplex edits a member, including resetting the nickname.
+func MemberEditComplex(client *discordgo.Session, guildID string, userID string, data *MemberEdit, options ...discordgo.RequestOption) (*discordgo.Member, error) {
+	body, err := client.RequestWithBucketID("PATCH", discordgo.EndpointGuildMember(guildID, userID), data, discordgo.EndpointGuildMember(guildID, ""), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	member := &discordgo.Member{}
+	if err := json.Unmarshal(body, member); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
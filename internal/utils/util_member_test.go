@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMemberEditMarshalJSON(t *testing.T) {
+	empty := ""
+	nick := "moderator"
+	timeout := "2030-01-02T03:04:05Z"
+	mute := true
+	tests := []struct {
+		name string
+		edit MemberEdit
+		want string
+	}{
+		{
+			name: "nothing",
+			edit: MemberEdit{},
+			want: `{}`,
+		},
+		{
+			name: "reset nickname",
+			edit: MemberEdit{Nick: &empty},
+			want: `{"nick":""}`,
+		},
+		{
+			name: "nickname and mute",
+			edit: MemberEdit{Nick: &nick, Mute: &mute},
+			want: `{"nick":"moderator","mute":true}`,
+		},
+		{
+			name: "timeout",
+			edit: MemberEdit{CommunicationDisabledUntil: &timeout},
+			want: `{"communication_disabled_until":"2030-01-02T03:04:05Z"}`,
+		},
+		{
+			name: "remove timeout",
+			edit: MemberEdit{CommunicationDisabledUntil: &empty},
+			want: `{"communication_disabled_until":null}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := json.Marshal(test.edit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != test.want {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// IsNotFound reports whether err is Discord answering that the object a request refers to does not exist. Discord
+// uses the 10xxx JSON error codes ("Unknown Channel", "Unknown Role", ...) for that, usually with a 404 status. A 404
+// without one of those codes is an unknown route, such as a wrong api_url, and is not treated as a deleted object.
+func IsNotFound(err error) bool {
+	var restErr *discordgo.RESTError
+	if !errors.As(err, &restErr) {
+		return false
+	}
+
+	return restErr.Message != nil && restErr.Message.Code >= 10000 && restErr.Message.Code < 20000
+}
+
+// RemoveNotFound removes a resource that was deleted outside Terraform from state, so that the next plan recreates
+// it instead of failing.
+func RemoveNotFound(ctx context.Context, resp *resource.ReadResponse, kind string, id string) {
+	tflog.Warn(ctx, "Discord object no longer exists, removing it from state", map[string]interface{}{
+		"kind": kind,
+		"id":   id,
+	})
+	resp.State.RemoveResource(ctx)
+}
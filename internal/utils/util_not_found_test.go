@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"errors"
+	"github.com/bwmarrin/discordgo"
+	"net/http"
+	"testing"
+)
+
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "unknown channel",
+			err: &discordgo.RESTError{
+				Response: &http.Response{StatusCode: http.StatusNotFound},
+				Message:  &discordgo.APIErrorMessage{Code: discordgo.ErrCodeUnknownChannel, Message: "Unknown Channel"},
+			},
+			want: true,
+		},
+		{
+			name: "unknown role without 404",
+			err: &discordgo.RESTError{
+				Response: &http.Response{StatusCode: http.StatusBadRequest},
+				Message:  &discordgo.APIErrorMessage{Code: discordgo.ErrCodeUnknownRole, Message: "Unknown Role"},
+			},
+			want: true,
+		},
+		{
+			name: "404 without a body",
+			err:  &discordgo.RESTError{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			want: false,
+		},
+		{
+			name: "unknown route",
+			err: &discordgo.RESTError{
+				Response: &http.Response{StatusCode: http.StatusNotFound},
+				Message:  &discordgo.APIErrorMessage{Code: 0, Message: "404: Not Found"},
+			},
+			want: false,
+		},
+		{
+			name: "missing access",
+			err: &discordgo.RESTError{
+				Response: &http.Response{StatusCode: http.StatusForbidden},
+				Message:  &discordgo.APIErrorMessage{Code: discordgo.ErrCodeMissingAccess, Message: "Missing Access"},
+			},
+			want: false,
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection refused"),
+			want: false,
+		},
+		{
+			name: "nil",
+			err:  nil,
+			want: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := IsNotFound(test.err); got != test.want {
+				t.Errorf("got %t, want %t", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenExpiryMargin refreshes Bearer tokens this long before Discord says they expire, so a token never runs out
+// between being handed to a request and the request reaching Discord.
+const tokenExpiryMargin = time.Minute
+
+// bearerEndpoints are the endpoints Discord only accepts a Bearer token for.
+var bearerEndpoints = []*regexp.Regexp{
+	regexp.MustCompile(`/applications/[0-9]+/guilds/[0-9]+/commands/permissions$`),
+	regexp.MustCompile(`/applications/[0-9]+/guilds/[0-9]+/commands/[0-9]+/permissions$`),
+}
+
+// RequiresBearer reports whether Discord only accepts a Bearer token for the request path.
+func RequiresBearer(path string) bool {
+	for _, endpoint := range bearerEndpoints {
+		if endpoint.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OAuth2TokenSource fetches Bearer tokens with the OAuth2 client credentials grant and refreshes them before they
+// expire.
+type OAuth2TokenSource struct {
+	ClientID string
+	Secret   string
+	Scopes   []string
+	// Client sends the token requests. It must not authenticate requests itself.
+	Client *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// oauth2TokenResponse is the body Discord answers a token request with.
+type oauth2TokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Token returns a valid access token, requesting a new one when there is none yet or it is about to expire.
+func (s *OAuth2TokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Now().Add(tokenExpiryMargin).Before(s.expiry) {
+		return s.token, nil
+	}
+
+	form := url.Values{
+		"grant_type": {"client_credentials"},
+		"scope":      {strings.Join(s.Scopes, " ")},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discordgo.EndpointOAuth2+"token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(s.ClientID), url.QueryEscape(s.Secret))
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request an OAuth2 token: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the OAuth2 token: %w", err)
+	}
+
+	var token oauth2TokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("failed to request an OAuth2 token: %s", resp.Status)
+	}
+	if resp.StatusCode != http.StatusOK || token.AccessToken == "" {
+		if token.ErrorDescription != "" {
+			return "", fmt.Errorf("failed to request an OAuth2 token: %s: %s", token.Error, token.ErrorDescription)
+		}
+		return "", fmt.Errorf("failed to request an OAuth2 token: %s", resp.Status)
+	}
+	if !strings.EqualFold(token.TokenType, "Bearer") {
+		return "", fmt.Errorf("unexpected OAuth2 token type %q", token.TokenType)
+	}
+
+	s.token = token.AccessToken
+	s.expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+
+	return s.token, nil
+}
+
+// AuthTransport authenticates requests to the Discord API with a Bearer token from Bearer. Requests that already carry
+// the bot token only switch to the Bearer token for the endpoints that require one. Requests to other hosts are sent
+// as they are, so the token never leaves Discord.
+type AuthTransport struct {
+	Bearer *OAuth2TokenSource
+	Next   http.RoundTripper
+}
+
+func (t *AuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Bearer != nil && isDiscordRequest(req) && (req.Header.Get("Authorization") == "" || RequiresBearer(req.URL.Path)) {
+		token, err := t.Bearer.Token(req.Context())
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return next.RoundTrip(req)
+}
@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func testOAuth2Server(t *testing.T, expiresIn int) (*httptest.Server, *int, *[]string) {
+	t.Helper()
+	var tokens int
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v9/oauth2/token" {
+			if clientID, secret, _ := r.BasicAuth(); clientID != "1234" || secret != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error": "invalid_client", "error_description": "Invalid client"}`))
+				return
+			}
+			tokens++
+			_, _ = fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": %d}`, tokens, expiresIn)
+			return
+		}
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &tokens, &authorizations
+}
+
+func testOAuth2Client(t *testing.T, server *httptest.Server, secret string) *http.Client {
+	t.Helper()
+	baseURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := &BaseURLTransport{BaseURL: baseURL}
+
+	return &http.Client{Transport: &AuthTransport{
+		Bearer: &OAuth2TokenSource{ClientID: "1234", Secret: secret, Client: &http.Client{Transport: base}},
+		Next:   base,
+	}}
+}
+
+func TestAuthTransport(t *testing.T) {
+	tests := []struct {
+		name          string
+		host          string
+		path          string
+		authorization string
+		want          string
+	}{
+		{name: "no bot token", path: "/api/v9/users/@me", want: "Bearer token-1"},
+		{name: "other host", host: "other", path: "/emoji.png", want: ""},
+		{name: "bot token", path: "/api/v9/users/@me", authorization: "Bot test", want: "Bot test"},
+		{name: "guild command permissions", path: "/api/v9/applications/1/guilds/2/commands/permissions", authorization: "Bot test", want: "Bearer token-1"},
+		{name: "command permissions", path: "/api/v9/applications/1/guilds/2/commands/3/permissions", authorization: "Bot test", want: "Bearer token-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _, authorizations := testOAuth2Server(t, 3600)
+			client := testOAuth2Client(t, server, "secret")
+
+			host := "https://discord.com"
+			if tt.host == "other" {
+				host = server.URL
+			}
+			req, err := http.NewRequest(http.MethodGet, host+tt.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if got := (*authorizations)[0]; got != tt.want {
+				t.Errorf("got authorization %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOAuth2TokenSource(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiresIn  int
+		wantTokens int
+	}{
+		{name: "reused", expiresIn: 3600, wantTokens: 1},
+		{name: "refreshed before expiry", expiresIn: 30, wantTokens: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, tokens, authorizations := testOAuth2Server(t, tt.expiresIn)
+			client := testOAuth2Client(t, server, "secret")
+
+			for i := 0; i < 3; i++ {
+				resp, err := client.Get("https://discord.com/api/v9/users/@me")
+				if err != nil {
+					t.Fatal(err)
+				}
+				resp.Body.Close()
+			}
+
+			if *tokens != tt.wantTokens {
+				t.Errorf("got %d tokens, want %d", *tokens, tt.wantTokens)
+			}
+			if got, want := (*authorizations)[2], fmt.Sprintf("Bearer token-%d", tt.wantTokens); got != want {
+				t.Errorf("got authorization %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestOAuth2TokenSourceInvalidClient(t *testing.T) {
+	server, _, authorizations := testOAuth2Server(t, 3600)
+	client := testOAuth2Client(t, server, "wrong")
+
+	_, err := client.Get("https://discord.com/api/v9/users/@me")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(*authorizations) != 0 {
+		t.Errorf("expected no API request without a token, got %d", len(*authorizations))
+	}
+}
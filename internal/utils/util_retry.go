@@ -0,0 +1,271 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// retryBaseDelay is the first backoff used for server errors. It doubles on every attempt.
+const retryBaseDelay = 500 * time.Millisecond
+
+// RetryTransport retries requests that Discord rate limited or failed with a server error. Rate limited requests wait
+// for as long as Discord asks, and a global rate limit holds back every request sent through the transport. Server and
+// network errors are only retried for methods that are safe to repeat.
+type RetryTransport struct {
+	Next http.RoundTripper
+	// MaxRetries is how many times a request is retried before the last response is returned.
+	MaxRetries int
+	// MaxWait is the longest a single retry waits. Responses asking for a longer wait are returned as is.
+	MaxWait time.Duration
+	// Timeout limits each attempt on its own, so waiting for a rate limit does not count against it.
+	Timeout time.Duration
+	// LogContext carries the provider logger, as requests made by discordgo do not. Defaults to the request context.
+	LogContext context.Context
+
+	semaphore   chan struct{}
+	mu          sync.Mutex
+	globalReset time.Time
+}
+
+// NewRetryTransport creates a RetryTransport. maxConcurrent limits the requests in flight, 0 means no limit.
+func NewRetryTransport(ctx context.Context, next http.RoundTripper, maxRetries int, maxWait time.Duration, maxConcurrent int, timeout time.Duration) *RetryTransport {
+	transport := &RetryTransport{
+		Next:       next,
+		MaxRetries: maxRetries,
+		MaxWait:    maxWait,
+		Timeout:    timeout,
+		LogContext: ctx,
+	}
+	if maxConcurrent > 0 {
+		transport.semaphore = make(chan struct{}, maxConcurrent)
+	}
+
+	return transport
+}
+
+// rateLimitBody is the body Discord sends with a 429 response.
+type rateLimitBody struct {
+	RetryAfter float64 `json:"retry_after"`
+	Global     bool    `json:"global"`
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := t.waitForGlobalReset(req.Context()); err != nil {
+			return nil, err
+		}
+		if attempt > 0 && req.Body != nil {
+			if req.GetBody == nil {
+				return nil, errors.New("request body cannot be replayed for a retry")
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		resp, err := t.send(req)
+		delay, retry, reason := t.retryDelay(req, resp, err, attempt)
+		if !retry {
+			return resp, err
+		}
+
+		logContext := t.LogContext
+		if logContext == nil {
+			logContext = req.Context()
+		}
+		tflog.Warn(logContext, "Retrying Discord API request", map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"reason":  reason,
+			"attempt": attempt + 1,
+			"delay":   delay.String(),
+		})
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *RetryTransport) send(req *http.Request) (*http.Response, error) {
+	if t.semaphore != nil {
+		select {
+		case t.semaphore <- struct{}{}:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		defer func() { <-t.semaphore }()
+	}
+
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if t.Timeout <= 0 {
+		return next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.Timeout)
+	resp, err := next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// cancelOnClose releases an attempt's timeout once its body has been read.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+
+	return c.ReadCloser.Close()
+}
+
+// retryDelay decides whether a response should be retried and how long to wait first.
+func (t *RetryTransport) retryDelay(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool, string) {
+	if attempt >= t.MaxRetries {
+		return 0, false, ""
+	}
+
+	var delay time.Duration
+	var reason string
+	switch {
+	case err != nil:
+		// Only requests that are safe to repeat are retried after a network error, the first attempt may have
+		// reached Discord.
+		if req.Context().Err() != nil || !isIdempotent(req.Method) {
+			return 0, false, ""
+		}
+		delay, reason = backoff(attempt), err.Error()
+	case resp.StatusCode == http.StatusTooManyRequests:
+		var global bool
+		delay, global = rateLimitDelay(resp)
+		if delay <= 0 {
+			delay = backoff(attempt)
+		}
+		reason = "rate limited"
+		if global {
+			reason = "globally rate limited"
+		}
+		if t.MaxWait > 0 && delay > t.MaxWait {
+			return 0, false, ""
+		}
+		if global {
+			t.setGlobalReset(time.Now().Add(delay))
+		}
+
+		return delay, true, reason
+	case resp.StatusCode == http.StatusInternalServerError, resp.StatusCode == http.StatusBadGateway,
+		resp.StatusCode == http.StatusServiceUnavailable, resp.StatusCode == http.StatusGatewayTimeout:
+		// Discord may have made the change before failing, so like network errors only safe requests are repeated.
+		if !isIdempotent(req.Method) {
+			return 0, false, ""
+		}
+		delay, reason = backoff(attempt), resp.Status
+	default:
+		return 0, false, ""
+	}
+
+	if t.MaxWait > 0 && delay > t.MaxWait {
+		delay = t.MaxWait
+	}
+
+	return delay, true, reason
+}
+
+// rateLimitDelay reads how long to wait from a 429 response. The body is the most precise source, followed by the
+// bucket reset header and then Retry-After. The body is put back so the caller can still read it.
+func rateLimitDelay(resp *http.Response) (time.Duration, bool) {
+	global := resp.Header.Get("X-RateLimit-Global") == "true" || resp.Header.Get("X-RateLimit-Scope") == "global"
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	var rateLimit rateLimitBody
+	if err == nil && json.Unmarshal(body, &rateLimit) == nil && rateLimit.RetryAfter > 0 {
+		return secondsToDuration(rateLimit.RetryAfter), global || rateLimit.Global
+	}
+
+	for _, header := range []string{"X-RateLimit-Reset-After", "Retry-After"} {
+		if seconds, err := strconv.ParseFloat(resp.Header.Get(header), 64); err == nil && seconds > 0 {
+			return secondsToDuration(seconds), global
+		}
+	}
+
+	return 0, global
+}
+
+func (t *RetryTransport) setGlobalReset(reset time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if reset.After(t.globalReset) {
+		t.globalReset = reset
+	}
+}
+
+func (t *RetryTransport) waitForGlobalReset(ctx context.Context) error {
+	t.mu.Lock()
+	wait := time.Until(t.globalReset)
+	t.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	return sleep(ctx, wait)
+}
+
+func backoff(attempt int) time.Duration {
+	delay := retryBaseDelay * time.Duration(math.Pow(2, float64(attempt)))
+
+	return delay + time.Duration(rand.Int63n(int64(delay)/2+1))
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,214 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRetryServer(t *testing.T, statuses []int, header http.Header, body string) (*httptest.Server, *int32) {
+	t.Helper()
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(atomic.AddInt32(&calls, 1)) - 1
+		status := http.StatusOK
+		if call < len(statuses) {
+			status = statuses[call]
+		}
+		if status != http.StatusOK {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+			return
+		}
+		requestBody, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(requestBody)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		statuses   []int
+		header     http.Header
+		body       string
+		maxRetries int
+		maxWait    time.Duration
+		wantStatus int
+		wantCalls  int32
+	}{
+		{
+			name:       "rate limit body",
+			statuses:   []int{http.StatusTooManyRequests},
+			body:       `{"message": "You are being rate limited.", "retry_after": 0.01, "global": false}`,
+			maxRetries: 3,
+			maxWait:    time.Second,
+			wantStatus: http.StatusOK,
+			wantCalls:  2,
+		},
+		{
+			name:       "rate limit header",
+			statuses:   []int{http.StatusTooManyRequests, http.StatusTooManyRequests},
+			header:     http.Header{"X-Ratelimit-Reset-After": {"0.01"}},
+			maxRetries: 3,
+			maxWait:    time.Second,
+			wantStatus: http.StatusOK,
+			wantCalls:  3,
+		},
+		{
+			name:       "rate limit longer than max wait",
+			statuses:   []int{http.StatusTooManyRequests},
+			header:     http.Header{"Retry-After": {"30"}},
+			maxRetries: 3,
+			maxWait:    time.Second,
+			wantStatus: http.StatusTooManyRequests,
+			wantCalls:  1,
+		},
+		{
+			name:       "server error",
+			method:     http.MethodPut,
+			statuses:   []int{http.StatusBadGateway, http.StatusServiceUnavailable},
+			maxRetries: 3,
+			maxWait:    10 * time.Millisecond,
+			wantStatus: http.StatusOK,
+			wantCalls:  3,
+		},
+		{
+			name:       "server error on create",
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusBadGateway},
+			maxRetries: 3,
+			maxWait:    10 * time.Millisecond,
+			wantStatus: http.StatusBadGateway,
+			wantCalls:  1,
+		},
+		{
+			name:       "retries exhausted",
+			method:     http.MethodPut,
+			statuses:   []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			maxRetries: 2,
+			maxWait:    10 * time.Millisecond,
+			wantStatus: http.StatusBadGateway,
+			wantCalls:  3,
+		},
+		{
+			name:       "client error",
+			statuses:   []int{http.StatusBadRequest},
+			maxRetries: 3,
+			maxWait:    10 * time.Millisecond,
+			wantStatus: http.StatusBadRequest,
+			wantCalls:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := testRetryServer(t, tt.statuses, tt.header, tt.body)
+			client := &http.Client{Transport: NewRetryTransport(context.Background(), nil, tt.maxRetries, tt.maxWait, 0, time.Second)}
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req, err := http.NewRequest(method, server.URL, bytes.NewBufferString(`{"name": "test"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", *calls, tt.wantCalls)
+			}
+			if tt.wantStatus == http.StatusOK && string(body) != `{"name": "test"}` {
+				t.Errorf("expected the request body to be replayed, got %q", body)
+			}
+			if tt.wantStatus != http.StatusOK && string(body) != tt.body {
+				t.Errorf("expected the last response body, got %q", body)
+			}
+		})
+	}
+}
+
+func TestRetryTransportGlobalRateLimit(t *testing.T) {
+	server, _ := testRetryServer(t, []int{http.StatusTooManyRequests}, http.Header{"X-Ratelimit-Global": {"true"}}, `{"retry_after": 0.2, "global": true}`)
+	transport := NewRetryTransport(context.Background(), nil, 3, time.Second, 0, time.Second)
+	client := &http.Client{Transport: transport}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		resp, err := client.Get(server.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+	// Give the first request time to hit the global rate limit.
+	time.Sleep(50 * time.Millisecond)
+
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	wg.Wait()
+	if waited := time.Since(start); waited < 100*time.Millisecond {
+		t.Errorf("expected the request to wait for the global rate limit, waited %s", waited)
+	}
+}
+
+func TestRetryTransportConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: NewRetryTransport(context.Background(), nil, 0, 0, 2, time.Second)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
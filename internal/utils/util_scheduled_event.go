@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+	"time"
+)
+
+// scheduledEventUsersPageSize is the most subscribed users Discord returns in one request.
+const scheduledEventUsersPageSize = 100
+
+// ScheduledEventFrequency is how often a recurring scheduled event happens.
+type ScheduledEventFrequency int
+
+const (
+	ScheduledEventFrequencyYearly ScheduledEventFrequency = iota
+	ScheduledEventFrequencyMonthly
+	ScheduledEventFrequencyWeekly
+	ScheduledEventFrequencyDaily
+)
+
+// ScheduledEventNWeekday is a weekday of a week of the month, like the second Tuesday. Days start at 0 for Monday.
+type ScheduledEventNWeekday struct {
+	N   int `json:"n"`
+	Day int `json:"day"`
+}
+
+// ScheduledEventRecurrenceRule is when a scheduled event repeats. discordgo does not support recurring events.
+type ScheduledEventRecurrenceRule struct {
+	Start      time.Time                `json:"start"`
+	End        *time.Time               `json:"end,omitempty"`
+	Frequency  ScheduledEventFrequency  `json:"frequency"`
+	Interval   int                      `json:"interval"`
+	ByWeekday  []int                    `json:"by_weekday"`
+	ByNWeekday []ScheduledEventNWeekday `json:"by_n_weekday"`
+	ByMonth    []int                    `json:"by_month"`
+	ByMonthDay []int                    `json:"by_month_day"`
+	Count      *int                     `json:"count,omitempty"`
+}
+
+// ScheduledEvent is a discordgo.GuildScheduledEvent with its recurrence rule.
+type ScheduledEvent struct {
+	discordgo.GuildScheduledEvent
+	RecurrenceRule *ScheduledEventRecurrenceRule `json:"recurrence_rule"`
+}
+
+// ScheduledEventParams is the body sent to create or edit a scheduled event. Every field is sent, so that a channel,
+// location, end time, description or recurrence rule can be removed with nil.
+type ScheduledEventParams struct {
+	Name               string                                       `json:"name"`
+	Description        *string                                      `json:"description"`
+	EntityType         discordgo.GuildScheduledEventEntityType      `json:"entity_type"`
+	ChannelID          *string                                      `json:"channel_id"`
+	EntityMetadata     *discordgo.GuildScheduledEventEntityMetadata `json:"entity_metadata"`
+	ScheduledStartTime time.Time                                    `json:"scheduled_start_time"`
+	ScheduledEndTime   *time.Time                                   `json:"scheduled_end_time"`
+	PrivacyLevel       discordgo.GuildScheduledEventPrivacyLevel    `json:"privacy_level"`
+	Status             discordgo.GuildScheduledEventStatus          `json:"status,omitempty"`
+	RecurrenceRule     *ScheduledEventRecurrenceRule                `json:"recurrence_rule"`
+	// Image is a data URI of the cover image. nil leaves the image unchanged and an empty string removes it.
+	Image *string `json:"-"`
+}
+
+// MarshalJSON sends an empty Image as null.
+func (p ScheduledEventParams) MarshalJSON() ([]byte, error) {
+	type scheduledEventParams ScheduledEventParams
+	v := struct {
+		scheduledEventParams
+		Image json.RawMessage `json:"image,omitempty"`
+	}{scheduledEventParams: scheduledEventParams(p)}
+	if p.Image != nil {
+		if *p.Image == "" {
+			v.Image = json.RawMessage(`null`)
+		} else {
+			image, err := json.Marshal(*p.Image)
+			if err != nil {
+				return nil, err
+			}
+			v.Image = image
+		}
+	}
+
+	return json.Marshal(v)
+}
+
+// GetScheduledEvent returns a scheduled event with its recurrence rule.
+func GetScheduledEvent(client *discordgo.Session, guildID string, eventID string, options ...discordgo.RequestOption) (*ScheduledEvent, error) {
+	endpoint := discordgo.EndpointGuildScheduledEvent(guildID, eventID)
+	body, err := client.RequestWithBucketID("GET", endpoint, nil, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalScheduledEvent(body)
+}
+
+// ScheduledEventCreateComplex creates a scheduled event, which may repeat.
+func ScheduledEventCreateComplex(client *discordgo.Session, guildID string, data *ScheduledEventParams, options ...discordgo.RequestOption) (*ScheduledEvent, error) {
+	endpoint := discordgo.EndpointGuildScheduledEvents(guildID)
+	body, err := client.RequestWithBucketID("POST", endpoint, data, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalScheduledEvent(body)
+}
+
+// ScheduledEventEditComplex edits a scheduled event, including removing fields and the recurrence rule.
+func ScheduledEventEditComplex(client *discordgo.Session, guildID string, eventID string, data *ScheduledEventParams, options ...discordgo.RequestOption) (*ScheduledEvent, error) {
+	endpoint := discordgo.EndpointGuildScheduledEvent(guildID, eventID)
+	body, err := client.RequestWithBucketID("PATCH", endpoint, data, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalScheduledEvent(body)
+}
+
+// GetAllScheduledEventUsers returns every user subscribed to a scheduled event, fetching as many pages as needed.
+func GetAllScheduledEventUsers(client *discordgo.Session, guildID string, eventID string, options ...discordgo.RequestOption) ([]*discordgo.GuildScheduledEventUser, error) {
+	var users []*discordgo.GuildScheduledEventUser
+	after := ""
+	for {
+		page, err := client.GuildScheduledEventUsers(guildID, eventID, scheduledEventUsersPageSize, false, "", after, options...)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, page...)
+		if len(page) < scheduledEventUsersPageSize {
+			return users, nil
+		}
+		after = page[len(page)-1].User.ID
+	}
+}
+
+func unmarshalScheduledEvent(body []byte) (*ScheduledEvent, error) {
+	event := &ScheduledEvent{}
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
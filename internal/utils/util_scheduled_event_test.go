@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScheduledEventParamsMarshalJSON(t *testing.T) {
+	empty := ""
+	image := "data:image/png;base64,AAAA"
+	start := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name     string
+		params   ScheduledEventParams
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "external without image",
+			params:   ScheduledEventParams{Name: "event", EntityType: discordgo.GuildScheduledEventEntityTypeExternal, ScheduledStartTime: start},
+			contains: []string{`"channel_id":null`, `"recurrence_rule":null`, `"scheduled_start_time":"2030-01-02T03:04:05Z"`},
+			excludes: []string{`"image"`, `"status"`},
+		},
+		{
+			name:     "remove image",
+			params:   ScheduledEventParams{Name: "event", Image: &empty},
+			contains: []string{`"image":null`},
+		},
+		{
+			name: "image and recurrence",
+			params: ScheduledEventParams{Name: "event", Image: &image, RecurrenceRule: &ScheduledEventRecurrenceRule{
+				Start:     start,
+				Frequency: ScheduledEventFrequencyWeekly,
+				Interval:  1,
+				ByWeekday: []int{1},
+			}},
+			contains: []string{`"image":"data:image/png;base64,AAAA"`, `"frequency":2`, `"by_weekday":[1]`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(string(got), want) {
+					t.Errorf("json.Marshal() = %s, want it to contain %s", got, want)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(string(got), unwanted) {
+					t.Errorf("json.Marshal() = %s, want it not to contain %s", got, unwanted)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ServerIDAttribute is the server_id attribute of resources that belong to a server. It falls back to the provider
+// default_server_id through ModifyPlanServerID.
+func ServerIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The server ID. Defaults to the provider `default_server_id`.",
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplaceIfConfigured(),
+		},
+	}
+}
+
+// ServerIDWithDefault returns serverID, or defaultServerID when serverID is not set. It is an error for both to be
+// empty.
+func ServerIDWithDefault(serverID types.String, defaultServerID string) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if serverID.IsUnknown() || serverID.ValueString() != "" {
+		return serverID, diags
+	}
+	if defaultServerID == "" {
+		diags.AddAttributeError(
+			path.Root("server_id"),
+			"missing server ID",
+			"`server_id` must be set when the provider does not set `default_server_id`.",
+		)
+		return serverID, diags
+	}
+
+	return types.StringValue(defaultServerID), diags
+}
+
+// ModifyPlanServerID plans the provider default_server_id for resources that do not set server_id. A resource moves
+// to the new default by being replaced, the same as when server_id itself changes.
+func ModifyPlanServerID(ctx context.Context, defaultServerID string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan for a destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configured types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("server_id"), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	serverID, diags := ServerIDWithDefault(configured, defaultServerID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+
+	if !req.State.Raw.IsNull() {
+		var current types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("server_id"), &current)...)
+		if !current.IsNull() && current.ValueString() != serverID.ValueString() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("server_id"))
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestServerIDWithDefault(t *testing.T) {
+	tests := []struct {
+		name            string
+		serverID        types.String
+		defaultServerID string
+		want            types.String
+		wantErr         bool
+	}{
+		{
+			name:            "set",
+			serverID:        types.StringValue("1"),
+			defaultServerID: "2",
+			want:            types.StringValue("1"),
+		},
+		{
+			name:            "default",
+			serverID:        types.StringNull(),
+			defaultServerID: "2",
+			want:            types.StringValue("2"),
+		},
+		{
+			name:     "unknown",
+			serverID: types.StringUnknown(),
+			want:     types.StringUnknown(),
+		},
+		{
+			name:     "missing",
+			serverID: types.StringNull(),
+			want:     types.StringNull(),
+			wantErr:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, diags := ServerIDWithDefault(test.serverID, test.defaultServerID)
+			if diags.HasError() != test.wantErr {
+				t.Fatalf("got errors %v, want error %t", diags, test.wantErr)
+			}
+			if !got.Equal(test.want) {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
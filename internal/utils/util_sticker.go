@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+)
+
+// discordgo has no methods for guild stickers, so the requests are made here.
+
+// StickerCreate is the form sent to create a sticker. Unlike other uploads, it is a plain multipart form without a
+// payload_json part.
+type StickerCreate struct {
+	Name        string
+	Description string
+	Tags        string
+	File        *discordgo.File
+}
+
+// StickerEdit is the body sent to edit a sticker. The file of a sticker cannot be changed.
+type StickerEdit struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description"`
+	Tags        string `json:"tags,omitempty"`
+}
+
+// GuildSticker returns a sticker of a server.
+func GuildSticker(client *discordgo.Session, guildID string, stickerID string, options ...discordgo.RequestOption) (*discordgo.Sticker, error) {
+	body, err := client.RequestWithBucketID("GET", discordgo.EndpointGuildSticker(guildID, stickerID), nil, discordgo.EndpointGuildStickers(guildID), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalSticker(body)
+}
+
+// StickerCreateComplex uploads a new sticker to a server.
+func StickerCreateComplex(client *discordgo.Session, guildID string, data *StickerCreate, options ...discordgo.RequestOption) (*discordgo.Sticker, error) {
+	contentType, form, err := stickerForm(data)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := discordgo.EndpointGuildStickers(guildID)
+	body, err := client.RequestWithLockedBucket("POST", endpoint, contentType, form, client.Ratelimiter.LockBucket(endpoint), 0, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalSticker(body)
+}
+
+// StickerEditComplex edits the name, description and tags of a sticker.
+func StickerEditComplex(client *discordgo.Session, guildID string, stickerID string, data *StickerEdit, options ...discordgo.RequestOption) (*discordgo.Sticker, error) {
+	body, err := client.RequestWithBucketID("PATCH", discordgo.EndpointGuildSticker(guildID, stickerID), data, discordgo.EndpointGuildStickers(guildID), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalSticker(body)
+}
+
+// GuildStickerDelete deletes a sticker of a server.
+func GuildStickerDelete(client *discordgo.Session, guildID string, stickerID string, options ...discordgo.RequestOption) error {
+	_, err := client.RequestWithBucketID("DELETE", discordgo.EndpointGuildSticker(guildID, stickerID), nil, discordgo.EndpointGuildStickers(guildID), options...)
+
+	return err
+}
+
+func stickerForm(data *StickerCreate) (string, []byte, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for _, field := range [][2]string{{"name", data.Name}, {"description", data.Description}, {"tags", data.Tags}} {
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return "", nil, err
+		}
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename=%q`, data.File.Name))
+	header.Set("Content-Type", data.File.ContentType)
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err := io.Copy(part, data.File.Reader); err != nil {
+		return "", nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return "", nil, err
+	}
+
+	return writer.FormDataContentType(), body.Bytes(), nil
+}
+
+func unmarshalSticker(body []byte) (*discordgo.Sticker, error) {
+	sticker := &discordgo.Sticker{}
+	if err := json.Unmarshal(body, sticker); err != nil {
+		return nil, err
+	}
+
+	return sticker, nil
+}
@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+)
+
+// discordgo decodes the snapshot of the server in a template into a Guild, which fails for the integer placeholder
+// IDs Discord uses in it, and its GuildTemplateCreate drops errors. Templates are requested here instead.
+
+// GuildTemplateURL is the URL that servers are created from a template with, followed by its code.
+const GuildTemplateURL = "https://discord.new/"
+
+// GuildTemplate is a template of a server, without the snapshot of the server.
+type GuildTemplate struct {
+	Code          string  `json:"code"`
+	Name          string  `json:"name"`
+	Description   *string `json:"description"`
+	UsageCount    int     `json:"usage_count"`
+	SourceGuildID string  `json:"source_guild_id"`
+	IsDirty       bool    `json:"is_dirty"`
+}
+
+// GuildTemplateParams is the body sent to create or edit a template. The description is always sent, so that it can
+// be removed.
+type GuildTemplateParams struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+// GetGuildTemplates returns the templates of a server. A server has at most one.
+func GetGuildTemplates(client *discordgo.Session, guildID string, options ...discordgo.RequestOption) ([]GuildTemplate, error) {
+	endpoint := discordgo.EndpointGuildTemplates(guildID)
+	body, err := client.RequestWithBucketID("GET", endpoint, nil, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+	var templates []GuildTemplate
+	if err := json.Unmarshal(body, &templates); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// GuildTemplateCreateComplex creates a template of the current state of a server.
+func GuildTemplateCreateComplex(client *discordgo.Session, guildID string, data *GuildTemplateParams, options ...discordgo.RequestOption) (*GuildTemplate, error) {
+	endpoint := discordgo.EndpointGuildTemplates(guildID)
+	body, err := client.RequestWithBucketID("POST", endpoint, data, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalGuildTemplate(body)
+}
+
+// GuildTemplateEditComplex edits the name and description of a template.
+func GuildTemplateEditComplex(client *discordgo.Session, guildID string, code string, data *GuildTemplateParams, options ...discordgo.RequestOption) (*GuildTemplate, error) {
+	body, err := client.RequestWithBucketID("PATCH", discordgo.EndpointGuildTemplateSync(guildID, code), data, discordgo.EndpointGuildTemplateSync(guildID, ""), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalGuildTemplate(body)
+}
+
+// SyncGuildTemplate updates a template to the current state of its server.
+func SyncGuildTemplate(client *discordgo.Session, guildID string, code string, options ...discordgo.RequestOption) (*GuildTemplate, error) {
+	body, err := client.RequestWithBucketID("PUT", discordgo.EndpointGuildTemplateSync(guildID, code), nil, discordgo.EndpointGuildTemplateSync(guildID, ""), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalGuildTemplate(body)
+}
+
+func unmarshalGuildTemplate(body []byte) (*GuildTemplate, error) {
+	template := &GuildTemplate{}
+	if err := json.Unmarshal(body, template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
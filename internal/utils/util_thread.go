@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"github.com/bwmarrin/discordgo"
+)
+
+func GetThreadType(value string) (discordgo.ChannelType, bool) {
+	switch value {
+	case "public":
+		return discordgo.ChannelTypeGuildPublicThread, true
+	case "private":
+		return discordgo.ChannelTypeGuildPrivateThread, true
+	case "announcement":
+		return discordgo.ChannelTypeGuildNewsThread, true
+	default:
+		return 0, false
+	}
+}
+
+func GetThreadTypeString(value discordgo.ChannelType) (string, bool) {
+	switch value {
+	case discordgo.ChannelTypeGuildPublicThread:
+		return "public", true
+	case discordgo.ChannelTypeGuildPrivateThread:
+		return "private", true
+	case discordgo.ChannelTypeGuildNewsThread:
+		return "announcement", true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+)
+
+// VoiceChannel is a discordgo.Channel with the voice fields discordgo does not decode.
+type VoiceChannel struct {
+	*discordgo.Channel
+	RTCRegion *string `json:"rtc_region"`
+}
+
+// VoiceChannelCreateData is a discordgo.GuildChannelCreateData with the voice fields discordgo does not send.
+type VoiceChannelCreateData struct {
+	discordgo.GuildChannelCreateData
+	RTCRegion *string `json:"rtc_region,omitempty"`
+}
+
+// VoiceChannelEdit is a discordgo.ChannelEdit with the voice fields discordgo does not send.
+// RTCRegion is always sent so that it can be reset to automatic.
+type VoiceChannelEdit struct {
+	discordgo.ChannelEdit
+	RTCRegion *string `json:"rtc_region"`
+}
+
+// GetVoiceChannel fetches a channel including the voice only fields.
+func GetVoiceChannel(client *discordgo.Session, channelID string, options ...discordgo.RequestOption) (*VoiceChannel, error) {
+	body, err := client.RequestWithBucketID("GET", discordgo.EndpointChannel(channelID), nil, discordgo.EndpointChannel(channelID), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalVoiceChannel(body)
+}
+
+// VoiceChannelCreateComplex creates a channel including the voice only fields.
+func VoiceChannelCreateComplex(client *discordgo.Session, guildID string, data VoiceChannelCreateData, options ...discordgo.RequestOption) (*VoiceChannel, error) {
+	body, err := client.RequestWithBucketID("POST", discordgo.EndpointGuildChannels(guildID), data, discordgo.EndpointGuildChannels(guildID), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalVoiceChannel(body)
+}
+
+// VoiceChannelEditComplex edits a channel including the voice only fields.
+func VoiceChannelEditComplex(client *discordgo.Session, channelID string, data *VoiceChannelEdit, options ...discordgo.RequestOption) (*VoiceChannel, error) {
+	body, err := client.RequestWithBucketID("PATCH", discordgo.EndpointChannel(channelID), data, discordgo.EndpointChannel(channelID), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalVoiceChannel(body)
+}
+
+func unmarshalVoiceChannel(body []byte) (*VoiceChannel, error) {
+	channel := &VoiceChannel{Channel: &discordgo.Channel{}}
+	if err := json.Unmarshal(body, channel); err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
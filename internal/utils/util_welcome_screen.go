@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"encoding/json"
+	"github.com/bwmarrin/discordgo"
+)
+
+// discordgo has no methods for the welcome screen of community servers, so the requests are made here.
+
+// WelcomeScreenChannel is a channel shown on the welcome screen. EmojiID is set for a custom emoji, and EmojiName
+// for a unicode one.
+type WelcomeScreenChannel struct {
+	ChannelID   string  `json:"channel_id"`
+	Description string  `json:"description"`
+	EmojiID     *string `json:"emoji_id"`
+	EmojiName   *string `json:"emoji_name"`
+}
+
+// WelcomeScreen is the welcome screen of a server. Whether it is enabled is a feature of the server.
+type WelcomeScreen struct {
+	Description     string                 `json:"description"`
+	WelcomeChannels []WelcomeScreenChannel `json:"welcome_channels"`
+}
+
+// WelcomeScreenEdit is the body sent to edit a welcome screen. The channels and description are always sent, so
+// that they can be removed.
+type WelcomeScreenEdit struct {
+	Enabled         bool                   `json:"enabled"`
+	WelcomeChannels []WelcomeScreenChannel `json:"welcome_channels"`
+	Description     *string                `json:"description"`
+}
+
+func endpointGuildWelcomeScreen(guildID string) string {
+	return discordgo.EndpointGuild(guildID) + "/welcome-screen"
+}
+
+// GetWelcomeScreen returns the welcome screen of a server.
+func GetWelcomeScreen(client *discordgo.Session, guildID string, options ...discordgo.RequestOption) (*WelcomeScreen, error) {
+	endpoint := endpointGuildWelcomeScreen(guildID)
+	body, err := client.RequestWithBucketID("GET", endpoint, nil, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalWelcomeScreen(body)
+}
+
+// WelcomeScreenEditComplex edits the welcome screen of a server.
+func WelcomeScreenEditComplex(client *discordgo.Session, guildID string, data *WelcomeScreenEdit, options ...discordgo.RequestOption) (*WelcomeScreen, error) {
+	if data.WelcomeChannels == nil {
+		data.WelcomeChannels = []WelcomeScreenChannel{}
+	}
+	endpoint := endpointGuildWelcomeScreen(guildID)
+	body, err := client.RequestWithBucketID("PATCH", endpoint, data, endpoint, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalWelcomeScreen(body)
+}
+
+func unmarshalWelcomeScreen(body []byte) (*WelcomeScreen, error) {
+	screen := &WelcomeScreen{}
+	if err := json.Unmarshal(body, screen); err != nil {
+		return nil, err
+	}
+
+	return screen, nil
+}
@@ -26,6 +26,8 @@ type ChannelTemplateData struct {
 	CanHaveParent       bool
 	CanHaveTopic        bool
 	CanHaveNSFW         bool
+	CanHaveTags         bool
+	IsVoice             bool
 }
 
 func main() {
@@ -77,6 +79,7 @@ func generateChannels(timestamp string) {
 			CanHaveParent:       true,
 			CanHaveTopic:        false,
 			CanHaveNSFW:         true,
+			IsVoice:             true,
 		},
 		{
 			Timestamp:           timestamp,
@@ -104,6 +107,27 @@ func generateChannels(timestamp string) {
 			CanHaveParent:       true,
 			CanHaveTopic:        true,
 			CanHaveNSFW:         false,
+			CanHaveTags:         true,
+		},
+		{
+			Timestamp:           timestamp,
+			ChannelType:         "Media",
+			ModelName:           "DiscordMediaChannel",
+			MarkdownDescription: "Discord Media Channel Resource",
+			CanHaveParent:       true,
+			CanHaveTopic:        true,
+			CanHaveNSFW:         false,
+			CanHaveTags:         true,
+		},
+		{
+			Timestamp:           timestamp,
+			ChannelType:         "Stage",
+			ModelName:           "DiscordStageChannel",
+			MarkdownDescription: "Discord Stage Channel Resource",
+			CanHaveParent:       true,
+			CanHaveTopic:        true,
+			CanHaveNSFW:         false,
+			IsVoice:             true,
 		},
 	}
 	for _, channel := range channels {